@@ -1,10 +1,12 @@
 package main
 
 import (
-	"log"
+	"os"
 
 	"github.com/hajimehoshi/ebiten/v2"
 
+	"github.com/JacobCromwell/Mazenasium/internal/game/config"
+	"github.com/JacobCromwell/Mazenasium/internal/game/logging"
 	"github.com/JacobCromwell/Mazenasium/internal/game/state"
 	"github.com/JacobCromwell/Mazenasium/internal/game/ui"
 )
@@ -15,15 +17,19 @@ type Game struct {
 }
 
 // Initialize new game
-func NewGame() *Game {
+func NewGame(cfg config.Config) *Game {
 	return &Game{
-		stateManager: state.New(ui.ScreenWidth, ui.ScreenHeight),
+		stateManager: state.NewWithConfig(cfg),
 	}
 }
 
 // Update game state
 func (g *Game) Update() error {
 	g.stateManager.Update()
+	if g.stateManager.ShouldExit {
+		// Stop the game loop and close the window
+		return ebiten.Termination
+	}
 	return nil
 }
 
@@ -40,7 +46,9 @@ func (g *Game) Draw(screen *ebiten.Image) {
         g.stateManager.ActionMgr,
         g.stateManager.MenuMgr,
 		g.stateManager.Flavor,
-        g.stateManager.Winner,
+        g.stateManager.AnimationMgr,
+        g.stateManager.EventLog,
+        g.stateManager.GameOverInfo(),
     )
 }
 
@@ -50,10 +58,13 @@ func (g *Game) Layout(outsideWidth, outsideHeight int) (int, int) {
 }
 
 func main() {
-	ebiten.SetWindowSize(ui.ScreenWidth, ui.ScreenHeight)
+	cfg := config.Default()
+	cfg.ScreenWidth, cfg.ScreenHeight = ui.ClampScreenSize(cfg.ScreenWidth, cfg.ScreenHeight)
+	ebiten.SetWindowSize(cfg.ScreenWidth, cfg.ScreenHeight)
 	ebiten.SetWindowTitle("Mazenasium")
 
-	if err := ebiten.RunGame(NewGame()); err != nil {
-		log.Fatal(err)
+	if err := ebiten.RunGame(NewGame(cfg)); err != nil {
+		logging.Default().Error("game stopped", "err", err)
+		os.Exit(1)
 	}
 }
\ No newline at end of file
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"github.com/JacobCromwell/Mazenasium/internal/game/config"
+)
+
+func TestUpdateTerminatesOnceExitIsRequested(t *testing.T) {
+	t.Chdir(t.TempDir())
+	game := NewGame(config.Default())
+
+	if err := game.Update(); err != nil {
+		t.Fatalf("Update() = %v before quitting, want nil", err)
+	}
+
+	game.stateManager.ShouldExit = true
+	if err := game.Update(); !errors.Is(err, ebiten.Termination) {
+		t.Errorf("Update() = %v after quitting, want ebiten.Termination", err)
+	}
+}
@@ -11,24 +11,67 @@ type ActionType int
 const (
 	XRotateLeft ActionType = iota
 	XRotateRight
+	ShuffleRow
+	PeekGoal
+	Swap
 	// Future actions can be added here
 )
 
+// Mode decides what limits how often actions can be used
+type Mode int
+
+const (
+	CooldownMode Mode = iota // Actions recharge after a number of frames
+	ChargesMode              // Actions have a fixed number of uses per match
+	UnlimitedMode            // Actions can always be used, for practice
+)
+
+// String returns the display name of the mode
+func (m Mode) String() string {
+	switch m {
+	case ChargesMode:
+		return "Charges"
+	case UnlimitedMode:
+		return "Unlimited"
+	}
+	return "Cooldown"
+}
+
+// Next returns the mode that follows this one when cycling through options.
+// UnlimitedMode is only used by practice matches and isn't part of the cycle
+func (m Mode) Next() Mode {
+	if m == ChargesMode {
+		return CooldownMode
+	}
+	return ChargesMode
+}
+
 // Action represents a player action
 type Action struct {
 	Type        ActionType
 	Name        string
 	Description string
 	Cooldown    int // Cooldown in frames
+	Charges     int // Uses per match in charges mode
 }
 
 // Manager handles player actions
 type Manager struct {
-	Actions       []Action
-	Cooldowns     map[ActionType]int // Current cooldown for each action
-	SelectedIndex int                // Currently selected action in the popup
+	Actions          []Action
+	Mode             Mode               // Cooldowns or limited charges
+	Cooldowns        map[ActionType]int // Current cooldown for each action
+	ChargesRemaining map[ActionType]int // Uses left for each action in charges mode
+	SelectedIndex    int                // Currently selected action in the popup
+	PageSize         int                // Actions listed per popup page
+	Page             int                // Popup page being shown, starting at 0
 }
 
+// DefaultPageSize is how many actions the popup lists at once
+const DefaultPageSize = 4
+
+// MaxPageSize matches the number keys 1-9 used to pick an action
+const MaxPageSize = 9
+
 // NewManager creates a new action manager
 func NewManager() *Manager {
 	// Initialize with default actions
@@ -38,12 +81,35 @@ func NewManager() *Manager {
 			Name:        "Rotate Row Left",
 			Description: "Rotate the current row to the left",
 			Cooldown:    120, // 2 seconds at 60 FPS
+			Charges:     3,
 		},
 		{
 			Type:        XRotateRight,
 			Name:        "Rotate Row Right",
 			Description: "Rotate the current row to the right",
 			Cooldown:    120,
+			Charges:     3,
+		},
+		{
+			Type:        ShuffleRow,
+			Name:        "Shuffle Row",
+			Description: "Randomly rearrange the current row",
+			Cooldown:    600, // 10 seconds at 60 FPS
+			Charges:     1,
+		},
+		{
+			Type:        PeekGoal,
+			Name:        "Peek Goal",
+			Description: "Briefly reveal the goal and the way to it",
+			Cooldown:    60,
+			Charges:     2,
+		},
+		{
+			Type:        Swap,
+			Name:        "Swap",
+			Description: "Trade places with the nearest NPC",
+			Cooldown:    1200, // 20 seconds at 60 FPS
+			Charges:     1,
 		},
 	}
 
@@ -52,10 +118,29 @@ func NewManager() *Manager {
 		cooldowns[action.Type] = 0
 	}
 
-	return &Manager{
+	manager := &Manager{
 		Actions:       actions,
+		Mode:          CooldownMode,
 		Cooldowns:     cooldowns,
 		SelectedIndex: -1, // No action selected by default
+		PageSize:      DefaultPageSize,
+	}
+	manager.ResetCharges()
+
+	return manager
+}
+
+// SetMode switches between cooldowns and charges, refilling all charges
+func (m *Manager) SetMode(mode Mode) {
+	m.Mode = mode
+	m.ResetCharges()
+}
+
+// ResetCharges gives every action its full number of uses
+func (m *Manager) ResetCharges() {
+	m.ChargesRemaining = make(map[ActionType]int)
+	for _, action := range m.Actions {
+		m.ChargesRemaining[action.Type] = action.Charges
 	}
 }
 
@@ -68,13 +153,30 @@ func (m *Manager) UpdateCooldowns() {
 	}
 }
 
-// IsActionAvailable checks if an action is available (not on cooldown)
+// IsActionAvailable checks if an action is available
+// (not on cooldown, or with charges left in charges mode)
 func (m *Manager) IsActionAvailable(actionType ActionType) bool {
+	if m.Mode == UnlimitedMode {
+		return true
+	}
+	if m.Mode == ChargesMode {
+		return m.ChargesRemaining[actionType] > 0
+	}
 	return m.Cooldowns[actionType] == 0
 }
 
-// UseAction puts an action on cooldown
+// UseAction puts an action on cooldown, or spends one of its charges
 func (m *Manager) UseAction(actionType ActionType) {
+	if m.Mode == UnlimitedMode {
+		return
+	}
+	if m.Mode == ChargesMode {
+		if m.ChargesRemaining[actionType] > 0 {
+			m.ChargesRemaining[actionType]--
+		}
+		return
+	}
+
 	for _, action := range m.Actions {
 		if action.Type == actionType {
 			m.Cooldowns[actionType] = action.Cooldown
@@ -83,6 +185,24 @@ func (m *Manager) UseAction(actionType ActionType) {
 	}
 }
 
+// RefundAction undoes UseAction: it clears the cooldown, or gives back
+// a charge without going over the action's per-match limit
+func (m *Manager) RefundAction(actionType ActionType) {
+	if m.Mode == UnlimitedMode {
+		return
+	}
+	if m.Mode == ChargesMode {
+		for _, action := range m.Actions {
+			if action.Type == actionType && m.ChargesRemaining[actionType] < action.Charges {
+				m.ChargesRemaining[actionType]++
+			}
+		}
+		return
+	}
+
+	m.Cooldowns[actionType] = 0
+}
+
 // GetAvailableActions returns a list of currently available actions
 func (m *Manager) GetAvailableActions() []Action {
 	available := []Action{}
@@ -94,28 +214,169 @@ func (m *Manager) GetAvailableActions() []Action {
 	return available
 }
 
-// GetActionByNumber returns an action by its number in the list (1-based)
+// pageSize returns the usable number of actions per page
+func (m *Manager) pageSize() int {
+	if m.PageSize < 1 {
+		return DefaultPageSize
+	}
+	if m.PageSize > MaxPageSize {
+		return MaxPageSize
+	}
+	return m.PageSize
+}
+
+// PageCount returns how many popup pages the available actions fill
+func (m *Manager) PageCount() int {
+	count := len(m.GetAvailableActions())
+	if count == 0 {
+		return 1
+	}
+	return (count + m.pageSize() - 1) / m.pageSize()
+}
+
+// CurrentPage returns the page being shown, kept within the page count
+// in case actions became unavailable since it was chosen
+func (m *Manager) CurrentPage() int {
+	if m.Page < 0 {
+		return 0
+	}
+	if last := m.PageCount() - 1; m.Page > last {
+		return last
+	}
+	return m.Page
+}
+
+// NextPage shows the following page, wrapping around to the first
+func (m *Manager) NextPage() {
+	m.Page = (m.CurrentPage() + 1) % m.PageCount()
+}
+
+// PrevPage shows the previous page, wrapping around to the last
+func (m *Manager) PrevPage() {
+	m.Page = (m.CurrentPage() - 1 + m.PageCount()) % m.PageCount()
+}
+
+// ResetPage goes back to the first page
+func (m *Manager) ResetPage() {
+	m.Page = 0
+}
+
+// PageActions returns the available actions listed on the current page
+func (m *Manager) PageActions() []Action {
+	availableActions := m.GetAvailableActions()
+	start := m.CurrentPage() * m.pageSize()
+	if start >= len(availableActions) {
+		return nil
+	}
+	end := start + m.pageSize()
+	if end > len(availableActions) {
+		end = len(availableActions)
+	}
+	return availableActions[start:end]
+}
+
+// GetActionByNumber returns an action by its number on the current page (1-based)
 // Returns nil if the number is invalid
 func (m *Manager) GetActionByNumber(number int) *Action {
-	availableActions := m.GetAvailableActions()
-	if number < 1 || number > len(availableActions) {
+	pageActions := m.PageActions()
+	if number < 1 || number > len(pageActions) {
 		return nil
 	}
 	
-	return &availableActions[number-1]
+	return &pageActions[number-1]
 }
 
-// FormatActionsList returns a formatted string of available actions
+// FormatActionsList returns a formatted string of the actions on the current page
 func (m *Manager) FormatActionsList() string {
-	availableActions := m.GetAvailableActions()
+	availableActions := m.PageActions()
 	if len(availableActions) == 0 {
 		return "No actions available"
 	}
 
 	result := "Available Actions:\n"
+	if pages := m.PageCount(); pages > 1 {
+		result = fmt.Sprintf("Available Actions (page %d/%d):\n", m.CurrentPage()+1, pages)
+	}
 	for i, action := range availableActions {
-		result += fmt.Sprintf("%d: %s - %s\n", i+1, action.Name, action.Description)
+		if m.Mode == ChargesMode {
+			result += fmt.Sprintf("%d: %s - %s [%s] (%d left)\n", i+1, action.Name, action.Description, m.chargePips(action), m.ChargesRemaining[action.Type])
+		} else {
+			result += fmt.Sprintf("%d: %s - %s\n", i+1, action.Name, action.Description)
+		}
+	}
+	if m.CurrentPage() < m.PageCount()-1 {
+		result += "more ▼\n"
 	}
 	
 	return result
-}
\ No newline at end of file
+}
+
+// chargePips draws an action's charges as one pip per use,
+// "*" for each one left and "-" for each one spent
+func (m *Manager) chargePips(action Action) string {
+	left := m.ChargesRemaining[action.Type]
+	pips := ""
+	for i := 0; i < action.Charges; i++ {
+		if i < left {
+			pips += "*"
+		} else {
+			pips += "-"
+		}
+	}
+	return pips
+}
+
+// FormatChargesHUD returns one line per action with pips for the charges
+// it has left, or an empty string in cooldown mode
+func (m *Manager) FormatChargesHUD() string {
+	if m.Mode != ChargesMode {
+		return ""
+	}
+
+	result := ""
+	for _, action := range m.Actions {
+		result += fmt.Sprintf("%s [%s]\n", action.Name, m.chargePips(action))
+	}
+	return result
+}
+
+// Snapshot is the saved cooldowns and charges of an action manager,
+// plain data that can be encoded for saves and replays
+type Snapshot struct {
+	Mode      Mode               `json:"mode"`
+	Cooldowns map[ActionType]int `json:"cooldowns"`
+	Charges   map[ActionType]int `json:"charges"`
+}
+
+// Snapshot returns a copy of the current cooldowns and charges
+func (m *Manager) Snapshot() Snapshot {
+	snapshot := Snapshot{
+		Mode:      m.Mode,
+		Cooldowns: make(map[ActionType]int, len(m.Cooldowns)),
+		Charges:   make(map[ActionType]int, len(m.ChargesRemaining)),
+	}
+	for actionType, cooldown := range m.Cooldowns {
+		snapshot.Cooldowns[actionType] = cooldown
+	}
+	for actionType, charges := range m.ChargesRemaining {
+		snapshot.Charges[actionType] = charges
+	}
+	return snapshot
+}
+
+// Restore reapplies a snapshot. Actions the snapshot leaves out start
+// fresh, and entries for action types this manager doesn't know are ignored
+func (m *Manager) Restore(snapshot Snapshot) {
+	m.Mode = snapshot.Mode
+	m.ResetCharges()
+
+	for _, action := range m.Actions {
+		m.Cooldowns[action.Type] = 0
+		if cooldown, ok := snapshot.Cooldowns[action.Type]; ok {
+			m.Cooldowns[action.Type] = min(max(cooldown, 0), action.Cooldown)
+		}
+		if charges, ok := snapshot.Charges[action.Type]; ok {
+			m.ChargesRemaining[action.Type] = min(max(charges, 0), action.Charges)
+		}
+	}
+}
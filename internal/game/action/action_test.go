@@ -0,0 +1,201 @@
+// internal/game/action/action_test.go
+package action
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// findAction returns the default settings of the given action
+func findAction(t *testing.T, m *Manager, actionType ActionType) Action {
+	t.Helper()
+	for _, a := range m.Actions {
+		if a.Type == actionType {
+			return a
+		}
+	}
+	t.Fatalf("no action of type %v", actionType)
+	return Action{}
+}
+
+func TestChargesDeplete(t *testing.T) {
+	m := NewManager()
+	m.SetMode(ChargesMode)
+	charges := findAction(t, m, XRotateLeft).Charges
+
+	for use := 1; use <= charges; use++ {
+		if !m.IsActionAvailable(XRotateLeft) {
+			t.Fatalf("action unavailable before use %d of %d", use, charges)
+		}
+		m.UseAction(XRotateLeft)
+	}
+
+	if m.IsActionAvailable(XRotateLeft) {
+		t.Error("action still available with no charges left")
+	}
+	if got := m.ChargesRemaining[XRotateLeft]; got != 0 {
+		t.Errorf("ChargesRemaining = %d, want 0", got)
+	}
+	if m.Cooldowns[XRotateLeft] != 0 {
+		t.Error("charges mode shouldn't start a cooldown")
+	}
+}
+
+func TestModeSwitchLeavesCooldownModeAlone(t *testing.T) {
+	m := NewManager()
+	m.SetMode(ChargesMode)
+	m.UseAction(ShuffleRow)
+	m.SetMode(CooldownMode)
+
+	if !m.IsActionAvailable(ShuffleRow) {
+		t.Fatal("a charge spent in charges mode shouldn't block cooldown mode")
+	}
+
+	m.UseAction(ShuffleRow)
+	if got, want := m.Cooldowns[ShuffleRow], findAction(t, m, ShuffleRow).Cooldown; got != want {
+		t.Errorf("cooldown = %d after use, want %d", got, want)
+	}
+	if m.IsActionAvailable(ShuffleRow) {
+		t.Error("action should be on cooldown")
+	}
+	for frame := 0; frame < findAction(t, m, ShuffleRow).Cooldown; frame++ {
+		m.UpdateCooldowns()
+	}
+	if !m.IsActionAvailable(ShuffleRow) {
+		t.Error("action should be available once the cooldown runs out")
+	}
+}
+
+func TestSwitchingModeRefillsCharges(t *testing.T) {
+	m := NewManager()
+	m.SetMode(ChargesMode)
+	m.UseAction(Swap)
+	m.SetMode(CooldownMode)
+	m.SetMode(ChargesMode)
+
+	if got, want := m.ChargesRemaining[Swap], findAction(t, m, Swap).Charges; got != want {
+		t.Errorf("ChargesRemaining = %d after switching modes, want a full %d", got, want)
+	}
+}
+
+func TestPageBoundaries(t *testing.T) {
+	m := NewManager()
+	m.PageSize = 2
+	total := len(m.GetAvailableActions())
+	if total != 5 {
+		t.Fatalf("%d actions available, the test expects 5", total)
+	}
+
+	wantSizes := []int{2, 2, 1}
+	if m.PageCount() != len(wantSizes) {
+		t.Fatalf("PageCount() = %d, want %d", m.PageCount(), len(wantSizes))
+	}
+	for page, size := range wantSizes {
+		if got := len(m.PageActions()); got != size {
+			t.Errorf("page %d lists %d actions, want %d", page+1, got, size)
+		}
+		if m.GetActionByNumber(size+1) != nil {
+			t.Errorf("page %d: number %d past the end picked an action", page+1, size+1)
+		}
+		m.NextPage()
+	}
+	if m.CurrentPage() != 0 {
+		t.Errorf("NextPage on the last page went to page %d, want the first", m.CurrentPage()+1)
+	}
+
+	m.PrevPage()
+	if m.CurrentPage() != len(wantSizes)-1 {
+		t.Errorf("PrevPage on the first page went to page %d, want the last", m.CurrentPage()+1)
+	}
+}
+
+func TestNumberOnLaterPageMapsToGlobalIndex(t *testing.T) {
+	m := NewManager()
+	m.PageSize = 2
+	m.NextPage()
+
+	got := m.GetActionByNumber(2)
+	want := m.GetAvailableActions()[3] // Second entry of page two
+	if got == nil || got.Type != want.Type {
+		t.Errorf("\"2\" on page two picked %v, want %s", got, want.Name)
+	}
+}
+
+func TestChargesHUDShowsSpentCharges(t *testing.T) {
+	m := NewManager()
+	if hud := m.FormatChargesHUD(); hud != "" {
+		t.Errorf("cooldown mode shows a charges HUD: %q", hud)
+	}
+
+	m.SetMode(ChargesMode)
+	name := findAction(t, m, XRotateLeft).Name
+	if !strings.Contains(m.FormatChargesHUD(), name+" [***]\n") {
+		t.Fatalf("full charges HUD %q, want %s with three pips", m.FormatChargesHUD(), name)
+	}
+
+	m.UseAction(XRotateLeft)
+	hud := m.FormatChargesHUD()
+	if !strings.Contains(hud, name+" [**-]\n") {
+		t.Errorf("HUD after one use %q, want %s with one pip spent", hud, name)
+	}
+	if !strings.Contains(m.FormatActionsList(), "(2 left)") {
+		t.Errorf("the actions list doesn't show the remaining charges: %q", m.FormatActionsList())
+	}
+}
+
+func TestSnapshotSurvivesJSON(t *testing.T) {
+	m := NewManager()
+	m.SetMode(ChargesMode)
+	m.UseAction(XRotateLeft)
+	m.UseAction(PeekGoal)
+	m.Cooldowns[Swap] = 7
+
+	data, err := json.Marshal(m.Snapshot())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded Snapshot
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	restored := NewManager()
+	restored.Restore(decoded)
+	if restored.Mode != ChargesMode {
+		t.Errorf("mode %v after restoring, want %v", restored.Mode, ChargesMode)
+	}
+	for _, a := range m.Actions {
+		if restored.ChargesRemaining[a.Type] != m.ChargesRemaining[a.Type] {
+			t.Errorf("%s: %d charges restored, want %d", a.Name, restored.ChargesRemaining[a.Type], m.ChargesRemaining[a.Type])
+		}
+		if restored.Cooldowns[a.Type] != m.Cooldowns[a.Type] {
+			t.Errorf("%s: cooldown %d restored, want %d", a.Name, restored.Cooldowns[a.Type], m.Cooldowns[a.Type])
+		}
+	}
+}
+
+func TestPartialSnapshotLeavesOtherActionsAtDefaults(t *testing.T) {
+	var partial Snapshot
+	data := fmt.Sprintf(`{"mode": %d, "charges": {"%d": 1}}`, ChargesMode, XRotateLeft)
+	if err := json.Unmarshal([]byte(data), &partial); err != nil {
+		t.Fatal(err)
+	}
+
+	m := NewManager()
+	m.UseAction(Swap) // Left over from before the restore
+	m.Restore(partial)
+
+	if got := m.ChargesRemaining[XRotateLeft]; got != 1 {
+		t.Errorf("X-Rotate Left has %d charges, want the saved 1", got)
+	}
+	for _, a := range m.Actions {
+		if a.Type != XRotateLeft && m.ChargesRemaining[a.Type] != a.Charges {
+			t.Errorf("%s has %d charges, want its default %d", a.Name, m.ChargesRemaining[a.Type], a.Charges)
+		}
+		if m.Cooldowns[a.Type] != 0 {
+			t.Errorf("%s is cooling down for %d frames, want it ready", a.Name, m.Cooldowns[a.Type])
+		}
+	}
+}
@@ -0,0 +1,89 @@
+// internal/game/animation/animation.go
+package animation
+
+import (
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"github.com/JacobCromwell/Mazenasium/internal/game/clock"
+)
+
+// Animation is a timed visual effect played by the animation manager
+type Animation interface {
+	// Duration returns how long the animation plays for
+	Duration() time.Duration
+	// Update advances the animation to the given progress (0.0 to 1.0)
+	Update(progress float64)
+	// Draw renders the current frame of the animation
+	Draw(screen *ebiten.Image)
+}
+
+// playing tracks an animation together with the time it started
+type playing struct {
+	anim  Animation
+	start time.Time
+}
+
+// Manager updates and draws all active animations
+type Manager struct {
+	active []*playing
+	Clock  clock.Clock // Time source for animation progress
+}
+
+// NewManager creates a new animation manager timed by the real clock
+func NewManager() *Manager {
+	return NewManagerWithClock(clock.Real)
+}
+
+// NewManagerWithClock creates a new animation manager timed by the given clock
+func NewManagerWithClock(c clock.Clock) *Manager {
+	return &Manager{
+		active: make([]*playing, 0),
+		Clock:  c,
+	}
+}
+
+// Play registers an animation and starts it immediately
+func (m *Manager) Play(anim Animation) {
+	m.active = append(m.active, &playing{anim: anim, start: m.Clock.Now()})
+}
+
+// Update advances all active animations and removes finished ones
+func (m *Manager) Update() {
+	remaining := m.active[:0]
+	for _, p := range m.active {
+		progress := 1.0
+		if d := p.anim.Duration(); d > 0 {
+			progress = float64(m.Clock.Since(p.start)) / float64(d)
+		}
+		if progress > 1 {
+			progress = 1
+		}
+
+		p.anim.Update(progress)
+
+		// Keep the animation until it has reached its final frame
+		if progress < 1 {
+			remaining = append(remaining, p)
+		}
+	}
+	m.active = remaining
+}
+
+// Draw renders all active animations in the order they were started
+func (m *Manager) Draw(screen *ebiten.Image) {
+	for _, p := range m.active {
+		p.anim.Draw(screen)
+	}
+}
+
+// IsPlaying checks if any animation is still running
+func (m *Manager) IsPlaying() bool {
+	return len(m.active) > 0
+}
+
+// Clear stops all active animations
+func (m *Manager) Clear() {
+	m.active = m.active[:0]
+}
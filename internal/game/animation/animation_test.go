@@ -0,0 +1,66 @@
+// internal/game/animation/animation_test.go
+package animation
+
+import (
+	"image/color"
+	"testing"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"github.com/JacobCromwell/Mazenasium/internal/game/clock"
+)
+
+// recording is an animation that remembers the progress it was given
+type recording struct {
+	duration time.Duration
+	progress []float64
+}
+
+func (r *recording) Duration() time.Duration   { return r.duration }
+func (r *recording) Update(progress float64)   { r.progress = append(r.progress, progress) }
+func (r *recording) Draw(screen *ebiten.Image) {}
+
+func TestManualClockDrivesAnimationToCompletion(t *testing.T) {
+	clk := clock.NewManualClock(time.Unix(0, 0))
+	m := NewManagerWithClock(clk)
+	anim := &recording{duration: time.Second}
+	m.Play(anim)
+
+	for step := 0; step < 4; step++ {
+		clk.Advance(250 * time.Millisecond)
+		m.Update()
+	}
+
+	want := []float64{0.25, 0.5, 0.75, 1}
+	if len(anim.progress) != len(want) {
+		t.Fatalf("progress %v, want %v", anim.progress, want)
+	}
+	for i := range want {
+		if anim.progress[i] != want[i] {
+			t.Errorf("update %d at progress %v, want %v", i, anim.progress[i], want[i])
+		}
+	}
+	if m.IsPlaying() {
+		t.Error("the animation is still playing after its full duration")
+	}
+}
+
+func TestAnimationWaitsForTheClock(t *testing.T) {
+	clk := clock.NewManualClock(time.Unix(0, 0))
+	m := NewManagerWithClock(clk)
+	m.Play(NewPulse(0, 0, 10, color.RGBA{255, 255, 255, 255}))
+
+	for i := 0; i < 100; i++ {
+		m.Update()
+	}
+	if !m.IsPlaying() {
+		t.Fatal("the pulse finished without the clock moving")
+	}
+
+	clk.Advance(time.Hour)
+	m.Update()
+	if m.IsPlaying() {
+		t.Error("the pulse still plays long after its duration")
+	}
+}
@@ -0,0 +1,106 @@
+// internal/game/animation/celebration.go
+package animation
+
+import (
+	"image/color"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+)
+
+// CelebrationDuration is how long the goal-reached celebration plays
+const CelebrationDuration = time.Second
+
+// particle is a single spark in the celebration burst
+type particle struct {
+	angle float64
+	speed float64 // Distance in pixels travelled over the full animation
+	size  float64
+	color color.RGBA
+}
+
+// Rect is a simple pixel rectangle
+type Rect struct {
+	X, Y, Width, Height float64
+}
+
+// Celebration plays a flash and particle burst when the goal is reached.
+// A veil over the game-over message fades out so the winner text fades in.
+type Celebration struct {
+	ScreenWidth, ScreenHeight int
+	Veil                      Rect // Area covering the winner text
+	VeilColor                 color.RGBA
+
+	particles []particle
+	progress  float64
+}
+
+// NewCelebration creates a celebration burst centered on the screen
+func NewCelebration(screenWidth, screenHeight int, veil Rect, veilColor color.RGBA) *Celebration {
+	palette := []color.RGBA{
+		{255, 215, 0, 255},   // Gold
+		{200, 0, 200, 255},   // Goal purple
+		{255, 255, 255, 255}, // White
+		{0, 200, 255, 255},   // Cyan
+	}
+
+	particles := make([]particle, 60)
+	for i := range particles {
+		particles[i] = particle{
+			angle: rand.Float64() * 2 * math.Pi,
+			speed: 150 + rand.Float64()*300,
+			size:  3 + rand.Float64()*5,
+			color: palette[i%len(palette)],
+		}
+	}
+
+	return &Celebration{
+		ScreenWidth:  screenWidth,
+		ScreenHeight: screenHeight,
+		Veil:         veil,
+		VeilColor:    veilColor,
+		particles:    particles,
+	}
+}
+
+// Duration returns how long the celebration plays
+func (c *Celebration) Duration() time.Duration {
+	return CelebrationDuration
+}
+
+// Update stores the current progress of the celebration
+func (c *Celebration) Update(progress float64) {
+	c.progress = progress
+}
+
+// Draw renders the flash, the particle burst and the fading veil
+func (c *Celebration) Draw(screen *ebiten.Image) {
+	// Fade the veil out so the winner text underneath fades in
+	veil := c.VeilColor
+	veil.A = uint8(float64(veil.A) * (1 - c.progress))
+	if veil.A > 0 {
+		ebitenutil.DrawRect(screen, c.Veil.X, c.Veil.Y, c.Veil.Width, c.Veil.Height, veil)
+	}
+
+	// Bright flash during the first part of the animation
+	if c.progress < 0.25 {
+		flashAlpha := uint8(180 * (1 - c.progress/0.25))
+		ebitenutil.DrawRect(screen, 0, 0, float64(c.ScreenWidth), float64(c.ScreenHeight), color.RGBA{255, 255, 255, flashAlpha})
+	}
+
+	// Particles fly outward from the center and fade as they go
+	centerX := float64(c.ScreenWidth) / 2
+	centerY := float64(c.ScreenHeight) / 2
+	for _, p := range c.particles {
+		distance := p.speed * c.progress
+		x := centerX + math.Cos(p.angle)*distance
+		y := centerY + math.Sin(p.angle)*distance
+
+		clr := p.color
+		clr.A = uint8(255 * (1 - c.progress))
+		ebitenutil.DrawRect(screen, x, y, p.size, p.size, clr)
+	}
+}
@@ -0,0 +1,52 @@
+// internal/game/animation/pulse.go
+package animation
+
+import (
+	"image/color"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+)
+
+// PulseDuration is how long a pulse plays
+const PulseDuration = 400 * time.Millisecond
+
+// Pulse draws an outline that grows out of a square and fades,
+// drawing attention to an entity that just changed place
+type Pulse struct {
+	X, Y, Size float64 // Square the pulse grows out of
+	Color      color.RGBA
+
+	progress float64
+}
+
+// NewPulse creates a pulse around the given square
+func NewPulse(x, y, size float64, clr color.RGBA) *Pulse {
+	return &Pulse{X: x, Y: y, Size: size, Color: clr}
+}
+
+// Duration returns how long the pulse plays
+func (p *Pulse) Duration() time.Duration {
+	return PulseDuration
+}
+
+// Update stores the current progress of the pulse
+func (p *Pulse) Update(progress float64) {
+	p.progress = progress
+}
+
+// Draw renders the growing, fading outline
+func (p *Pulse) Draw(screen *ebiten.Image) {
+	grow := p.Size * p.progress
+	x, y := p.X-grow/2, p.Y-grow/2
+	size := p.Size + grow
+
+	clr := p.Color
+	clr.A = uint8(float64(clr.A) * (1 - p.progress))
+
+	ebitenutil.DrawRect(screen, x, y, size, 2, clr)        // Top
+	ebitenutil.DrawRect(screen, x, y+size-2, size, 2, clr) // Bottom
+	ebitenutil.DrawRect(screen, x, y, 2, size, clr)        // Left
+	ebitenutil.DrawRect(screen, x+size-2, y, 2, size, clr) // Right
+}
@@ -0,0 +1,67 @@
+// internal/game/clock/clock.go
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock tells the time. Systems that measure elapsed time take a Clock
+// instead of calling time.Now, so tests can step time by hand
+type Clock interface {
+	Now() time.Time
+	Since(t time.Time) time.Duration
+}
+
+// RealClock reads the system time
+type RealClock struct{}
+
+// Now returns the current system time
+func (RealClock) Now() time.Time {
+	return time.Now()
+}
+
+// Since returns the system time elapsed since t
+func (RealClock) Since(t time.Time) time.Duration {
+	return time.Since(t)
+}
+
+// Real is the clock used unless another one is injected
+var Real Clock = RealClock{}
+
+// ManualClock only moves when told to, for deterministic tests and replays
+type ManualClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewManualClock creates a clock stopped at start
+func NewManualClock(start time.Time) *ManualClock {
+	return &ManualClock{now: start}
+}
+
+// Now returns the clock's current time
+func (c *ManualClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Since returns the clock's time elapsed since t
+func (c *ManualClock) Since(t time.Time) time.Duration {
+	return c.Now().Sub(t)
+}
+
+// Advance moves the clock forward by d
+func (c *ManualClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// Set moves the clock to t
+func (c *ManualClock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = t
+}
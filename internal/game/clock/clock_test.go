@@ -0,0 +1,24 @@
+// internal/game/clock/clock_test.go
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+func TestManualClockOnlyMovesWhenTold(t *testing.T) {
+	start := time.Unix(100, 0)
+	c := NewManualClock(start)
+
+	if !c.Now().Equal(start) || c.Since(start) != 0 {
+		t.Fatalf("a new clock reads %v, want %v", c.Now(), start)
+	}
+	c.Advance(1500 * time.Millisecond)
+	if got := c.Since(start); got != 1500*time.Millisecond {
+		t.Errorf("Since(start) = %v after advancing 1.5s", got)
+	}
+	c.Set(start)
+	if got := c.Since(start); got != 0 {
+		t.Errorf("Since(start) = %v after setting the clock back", got)
+	}
+}
@@ -0,0 +1,111 @@
+// internal/game/config/config.go
+package config
+
+import (
+	"image/color"
+	"time"
+
+	"github.com/JacobCromwell/Mazenasium/internal/game/action"
+	"github.com/JacobCromwell/Mazenasium/internal/game/maze"
+	"github.com/JacobCromwell/Mazenasium/internal/game/motion"
+	"github.com/JacobCromwell/Mazenasium/internal/game/trivia"
+	"github.com/JacobCromwell/Mazenasium/internal/game/ui"
+)
+
+// Config holds the player-adjustable game settings.
+// It survives restarts so choices made in the Customize menu stick
+type Config struct {
+	ScreenShake  bool    // Shake the screen on traps and penalties
+	GoalPulse    bool    // Pulse a glow around the goal, off for reduced motion
+	HighContrast bool    // White text on solid dark boxes for readability
+	DebugKeys    bool    // Allow the V reveal, H heatmap and G coordinates keys
+	ExploreHint  bool    // Point toward the nearest unexplored tile
+	FitMaze      bool    // Scale a maze too big for its section down to fit
+	Lighting     bool    // Dim tiles with distance from the player, off for full visibility
+	LightRadius  float64 // Tiles the player's light reaches when Lighting is on
+	TileSize     float64 // Size of each maze tile in pixels
+
+	ScreenWidth, ScreenHeight int // Window size in pixels, clamped to what the UI supports
+
+	MoveEasing      motion.Easing // Curve entities follow as they slide between cells
+	InstantMovement bool          // Snap entities between cells instead of sliding
+	WallStyle       ui.WallStyle  // How wall tiles are drawn
+
+	MazeSymmetry maze.Symmetry // Mirror or rotational symmetry of generated mazes
+	ActionMode   action.Mode   // Cooldowns or limited charges per match
+	Seed         int64         // Seed for reproducible mazes, 0 means random
+
+	ActionsPerPage    int            // Actions listed per page of the action popup
+	ActionPopupAnchor ui.PopupAnchor // Where the action popup is placed
+
+	ExtraPathFactor float64 // Loop density, 0 is a perfect maze
+	BorderThickness int     // Wall frame around the maze, in tiles
+	RoomCount       int     // Open rooms carved among the corridors, 0 for none
+	RoomSize        int     // Largest room side in tiles
+
+	StartCorner maze.Corner // Corner the player starts in
+	GoalCorner  maze.Corner // Corner the goal is placed in, or opposite the start
+
+	MinSpawnSeparation int // Fewest steps between NPC spawns and the player, 0 for no limit
+	ShrinkEvery        int // Rounds between the maze walling its outer ring, 0 never shrinks
+
+	PlayerMovesPerTurn int // Steps the player takes before the action phase
+
+	WinMode       WinMode // Race to the goal, survive the chasers or score trivia points
+	SurvivalTurns int     // Rounds the player has to last in survival mode
+	TargetScore   int     // Trivia points that win a trivia score match
+
+	NPCMovesPerTurn int          // Steps each NPC takes per turn, higher is harder
+	NPCDelayFrames  int          // Pause between NPC moves in frames, 0 for none
+	NPCPalette      []color.RGBA // Colors handed out to NPCs by index, nil for npc.DefaultPalette
+	ChaseMode       bool         // NPCs hunt the player, who loses if caught
+	NPCWanderChance float64      // Chance (0-1) a chasing or racing NPC wanders for a turn instead
+	NPCRotateChance float64      // Chance (0-1) an NPC rotates its row against the player instead of moving
+	NPCMixedMoves   bool         // Give some NPCs knight or diagonal moves instead of orthogonal steps
+
+	AutoSave bool // Save the match at the end of every player turn so it can be continued
+	CoopMode bool // Two humans take turns and win together if either reaches the goal
+
+	TriviaFrequency   int           // Player moves between trivia questions, 0 never asks
+	TriviaShuffle     bool          // Show trivia options in a random order
+	TriviaResultDelay time.Duration // How long a trivia result stays up without a key press
+	HintCost          int           // Points a hint such as Peek Goal costs
+	TriviaSummaryPath string        // JSON file the match's trivia answers are saved to, empty to skip
+	TriviaStatsPath   string        // JSON file per-question results are kept in between sessions, empty to skip
+
+	WrongAnswerPenalty trivia.WrongAnswerPenalty // What a wrong trivia answer costs the player
+}
+
+// Default returns the settings used when the game starts
+func Default() Config {
+	return Config{
+		ScreenShake: true,
+		GoalPulse:   true,
+		FitMaze:     true,
+		LightRadius: ui.DefaultLightRadius,
+		TileSize:    maze.TileSize,
+
+		ScreenWidth:  ui.DefaultScreenWidth,
+		ScreenHeight: ui.DefaultScreenHeight,
+
+		ActionsPerPage: action.DefaultPageSize,
+
+		PlayerMovesPerTurn: 1,
+
+		SurvivalTurns: DefaultSurvivalTurns,
+		TargetScore:   DefaultTargetScore,
+
+		NPCMovesPerTurn: 1,
+		ExtraPathFactor: maze.DefaultExtraPathFactor,
+		BorderThickness: maze.DefaultBorderThickness,
+		RoomSize:        maze.DefaultRoomMaxSize,
+
+		StartCorner: maze.TopLeftCorner,
+		GoalCorner:  maze.OppositeCorner,
+
+		MinSpawnSeparation: maze.DefaultMinSpawnSeparation,
+
+		TriviaResultDelay: 3 * time.Second,
+		HintCost:          1,
+	}
+}
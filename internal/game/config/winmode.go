@@ -0,0 +1,34 @@
+// internal/game/config/winmode.go
+package config
+
+// WinMode decides how the player wins a match
+type WinMode int
+
+const (
+	RaceToGoal  WinMode = iota // First to reach the goal wins
+	Survival                   // The player wins by not being caught for SurvivalTurns
+	TriviaScore                // The player wins by scoring TargetScore trivia points
+)
+
+// String returns the name shown in the menu
+func (w WinMode) String() string {
+	switch w {
+	case Survival:
+		return "Survival"
+	case TriviaScore:
+		return "Trivia Score"
+	default:
+		return "Race to Goal"
+	}
+}
+
+// Next returns the win mode that follows this one in the menu cycle
+func (w WinMode) Next() WinMode {
+	return (w + 1) % 3
+}
+
+// DefaultSurvivalTurns is how many rounds the player has to last in survival mode
+const DefaultSurvivalTurns = 20
+
+// DefaultTargetScore is how many trivia points win a trivia score match
+const DefaultTargetScore = 5
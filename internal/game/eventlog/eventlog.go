@@ -0,0 +1,77 @@
+// internal/game/eventlog/eventlog.go
+package eventlog
+
+import (
+	"fmt"
+)
+
+// DefaultCapacity is the number of entries kept when none is specified
+const DefaultCapacity = 8
+
+// Entry is a single recorded game event
+type Entry struct {
+	Turn    int
+	Message string
+}
+
+// String formats the entry for display
+func (e Entry) String() string {
+	return fmt.Sprintf("T%d %s", e.Turn, e.Message)
+}
+
+// Log is a fixed-size ring buffer of recent game events
+type Log struct {
+	entries []Entry
+	start   int // Index of the oldest entry
+	count   int // Number of entries currently stored
+}
+
+// New creates an event log holding at most capacity entries
+func New(capacity int) *Log {
+	if capacity < 1 {
+		capacity = DefaultCapacity
+	}
+	return &Log{
+		entries: make([]Entry, capacity),
+	}
+}
+
+// Add records an event, dropping the oldest entry when the log is full
+func (l *Log) Add(turn int, msg string) {
+	entry := Entry{Turn: turn, Message: msg}
+
+	if l.count < len(l.entries) {
+		l.entries[(l.start+l.count)%len(l.entries)] = entry
+		l.count++
+		return
+	}
+
+	// Overwrite the oldest entry and advance the start
+	l.entries[l.start] = entry
+	l.start = (l.start + 1) % len(l.entries)
+}
+
+// Entries returns the stored events from oldest to newest
+func (l *Log) Entries() []Entry {
+	result := make([]Entry, 0, l.count)
+	for i := 0; i < l.count; i++ {
+		result = append(result, l.entries[(l.start+i)%len(l.entries)])
+	}
+	return result
+}
+
+// Len returns the number of stored events
+func (l *Log) Len() int {
+	return l.count
+}
+
+// Capacity returns the maximum number of stored events
+func (l *Log) Capacity() int {
+	return len(l.entries)
+}
+
+// Clear removes all events
+func (l *Log) Clear() {
+	l.start = 0
+	l.count = 0
+}
@@ -0,0 +1,55 @@
+// internal/game/eventlog/eventlog_test.go
+package eventlog
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestAddPastCapacityDropsOldest(t *testing.T) {
+	log := New(3)
+	for i := 1; i <= 4; i++ {
+		log.Add(i, fmt.Sprintf("event %d", i))
+	}
+
+	if log.Len() != 3 {
+		t.Fatalf("Len() = %d, want 3", log.Len())
+	}
+
+	entries := log.Entries()
+	if entries[0].Message != "event 2" {
+		t.Errorf("oldest entry = %q, want %q", entries[0].Message, "event 2")
+	}
+	for _, entry := range entries {
+		if entry.Message == "event 1" {
+			t.Error("the oldest entry should have been dropped")
+		}
+	}
+}
+
+func TestNewestEntryRendersLast(t *testing.T) {
+	log := New(DefaultCapacity)
+	for i := 1; i <= DefaultCapacity+2; i++ {
+		log.Add(i, fmt.Sprintf("event %d", i))
+	}
+
+	entries := log.Entries()
+	last := entries[len(entries)-1]
+	if last.Turn != DefaultCapacity+2 {
+		t.Errorf("last entry is from turn %d, want %d", last.Turn, DefaultCapacity+2)
+	}
+	if got, want := last.String(), fmt.Sprintf("T%d event %d", DefaultCapacity+2, DefaultCapacity+2); got != want {
+		t.Errorf("last entry renders as %q, want %q", got, want)
+	}
+	for i := 1; i < len(entries); i++ {
+		if entries[i].Turn < entries[i-1].Turn {
+			t.Fatalf("entries out of order: turn %d before turn %d", entries[i-1].Turn, entries[i].Turn)
+		}
+	}
+}
+
+func TestNewFallsBackToDefaultCapacity(t *testing.T) {
+	if got := New(0).Capacity(); got != DefaultCapacity {
+		t.Errorf("Capacity() = %d, want %d", got, DefaultCapacity)
+	}
+}
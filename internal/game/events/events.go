@@ -0,0 +1,51 @@
+// internal/game/events/events.go
+package events
+
+// Type identifies what happened in the game
+type Type int
+
+const (
+	TurnChanged Type = iota // Play passed to the player or the NPCs
+	ActionUsed              // The player used an action
+	PlayerMoved             // The player arrived on a new cell
+	GameWon                 // Someone won the match
+)
+
+// Event describes something that happened. Only the fields that make
+// sense for the event's type are filled in
+type Event struct {
+	Type       Type
+	Turn       int    // Turn number the event happened on
+	PlayerTurn bool   // TurnChanged: whether it is now the player's turn
+	Action     string // ActionUsed: display name of the action
+	X, Y       int    // PlayerMoved: the cell the player arrived on
+	Winner     string // GameWon: who won the match
+}
+
+// Handler reacts to a published event
+type Handler func(Event)
+
+// Bus delivers published events to the handlers subscribed to their type
+type Bus struct {
+	handlers map[Type][]Handler
+}
+
+// NewBus creates an event bus with no subscribers
+func NewBus() *Bus {
+	return &Bus{
+		handlers: make(map[Type][]Handler),
+	}
+}
+
+// Subscribe registers a handler for every event of the given type
+func (b *Bus) Subscribe(eventType Type, handler Handler) {
+	b.handlers[eventType] = append(b.handlers[eventType], handler)
+}
+
+// Publish calls the handlers subscribed to the event's type,
+// in the order they subscribed
+func (b *Bus) Publish(event Event) {
+	for _, handler := range b.handlers[event.Type] {
+		handler(event)
+	}
+}
@@ -0,0 +1,29 @@
+// internal/game/events/events_test.go
+package events
+
+import "testing"
+
+func TestPublishCallsSubscribersInOrder(t *testing.T) {
+	bus := NewBus()
+	var calls []string
+	bus.Subscribe(ActionUsed, func(e Event) { calls = append(calls, "first "+e.Action) })
+	bus.Subscribe(ActionUsed, func(e Event) { calls = append(calls, "second "+e.Action) })
+	bus.Subscribe(GameWon, func(e Event) { calls = append(calls, "won") })
+
+	bus.Publish(Event{Type: ActionUsed, Action: "Swap"})
+
+	if len(calls) != 2 || calls[0] != "first Swap" || calls[1] != "second Swap" {
+		t.Errorf("handlers called %v, want both ActionUsed handlers in order", calls)
+	}
+}
+
+func TestPublishWithoutSubscribers(t *testing.T) {
+	bus := NewBus()
+	called := false
+	bus.Subscribe(PlayerMoved, func(Event) { called = true })
+
+	bus.Publish(Event{Type: TurnChanged})
+	if called {
+		t.Error("a PlayerMoved handler ran for a TurnChanged event")
+	}
+}
@@ -0,0 +1,111 @@
+// internal/game/flavor/clip.go
+package flavor
+
+import (
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// DefaultFrameDuration is how long each frame of a clip stays up, in seconds
+const DefaultFrameDuration = 0.15
+
+// clipFrameName matches numbered animation frames such as anim_0.png,
+// capturing the clip name and the frame number
+var clipFrameName = regexp.MustCompile(`^(.+)_(\d+)\.[A-Za-z]+$`)
+
+// Clip is a looping frame-based animation shown in place of a still image
+type Clip struct {
+	Frames        []*ebiten.Image
+	FrameDuration float64 // Seconds per frame
+
+	index   int
+	elapsed float64
+}
+
+// NewClip creates a clip that plays the frames in order, looping.
+// A frame duration of 0 or less uses DefaultFrameDuration
+func NewClip(frames []*ebiten.Image, frameDuration float64) *Clip {
+	if frameDuration <= 0 {
+		frameDuration = DefaultFrameDuration
+	}
+	return &Clip{
+		Frames:        frames,
+		FrameDuration: frameDuration,
+	}
+}
+
+// Update advances the clip by dt seconds, wrapping back to the first frame
+func (c *Clip) Update(dt float64) {
+	if c == nil || len(c.Frames) < 2 || dt <= 0 {
+		return
+	}
+	c.elapsed += dt
+	for c.elapsed >= c.FrameDuration {
+		c.elapsed -= c.FrameDuration
+		c.index = (c.index + 1) % len(c.Frames)
+	}
+}
+
+// Reset rewinds the clip to its first frame
+func (c *Clip) Reset() {
+	c.index = 0
+	c.elapsed = 0
+}
+
+// FrameIndex returns the index of the frame currently showing
+func (c *Clip) FrameIndex() int {
+	return c.index
+}
+
+// Frame returns the frame currently showing, nil for an empty clip
+func (c *Clip) Frame() *ebiten.Image {
+	if c == nil || len(c.Frames) == 0 {
+		return nil
+	}
+	return c.Frames[c.index]
+}
+
+// groupClipFrames splits file names into clips of numbered frames and the
+// remaining still images. Clips are keyed by their first frame's name and
+// list their frames in numeric order; a lone numbered file stays a still
+func groupClipFrames(names []string) (clips map[string][]string, stills []string) {
+	type frame struct {
+		name   string
+		number int
+	}
+	groups := make(map[string][]frame)
+	for _, name := range names {
+		match := clipFrameName.FindStringSubmatch(name)
+		if match == nil {
+			stills = append(stills, name)
+			continue
+		}
+		number, err := strconv.Atoi(match[2])
+		if err != nil {
+			stills = append(stills, name)
+			continue
+		}
+		key := match[1] + filepath.Ext(name)
+		groups[key] = append(groups[key], frame{name: name, number: number})
+	}
+
+	clips = make(map[string][]string)
+	for _, frames := range groups {
+		if len(frames) < 2 {
+			stills = append(stills, frames[0].name)
+			continue
+		}
+		sort.Slice(frames, func(i, j int) bool { return frames[i].number < frames[j].number })
+		ordered := make([]string, len(frames))
+		for i, f := range frames {
+			ordered[i] = f.name
+		}
+		clips[ordered[0]] = ordered
+	}
+	sort.Strings(stills)
+	return clips, stills
+}
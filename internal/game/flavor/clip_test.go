@@ -0,0 +1,42 @@
+// internal/game/flavor/clip_test.go
+package flavor
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+func TestClipAdvancesAndLoops(t *testing.T) {
+	frames := []*ebiten.Image{ebiten.NewImage(1, 1), ebiten.NewImage(1, 1), ebiten.NewImage(1, 1)}
+	c := NewClip(frames, 0.1)
+
+	// Half-frame steps, so each frame shows for two updates
+	want := []int{0, 0, 1, 1, 2, 2, 0, 0, 1}
+	for step, index := range want {
+		if c.FrameIndex() != index || c.Frame() != frames[index] {
+			t.Fatalf("after %d half-frame steps, frame %d is showing, want %d", step, c.FrameIndex(), index)
+		}
+		c.Update(0.05 + 1e-9)
+	}
+}
+
+func TestClipCatchesUpAfterALongFrame(t *testing.T) {
+	c := NewClip(make([]*ebiten.Image, 4), 0.1)
+	c.Update(0.55) // Five and a half frames
+	if c.FrameIndex() != 1 {
+		t.Errorf("frame %d after 0.55s, want 1 (5 frames on, looped once)", c.FrameIndex())
+	}
+}
+
+func TestGroupClipFramesOrdersNumerically(t *testing.T) {
+	clips, stills := groupClipFrames([]string{"anim_10.png", "anim_2.png", "anim_1.png", "hall.jpg", "lone_1.png"})
+
+	if got := fmt.Sprint(clips["anim_1.png"]); got != "[anim_1.png anim_2.png anim_10.png]" {
+		t.Errorf("anim frames %s, want them in numeric order", got)
+	}
+	if got := fmt.Sprint(stills); got != "[hall.jpg lone_1.png]" {
+		t.Errorf("stills %s, want the plain image and the lone numbered one", got)
+	}
+}
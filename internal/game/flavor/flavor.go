@@ -4,25 +4,115 @@ package flavor
 import (
 	"fmt"
     "image"
+    _ "image/gif"  // Register GIF decoder
     _ "image/jpeg" // Register JPEG decoder
+    _ "image/png"  // Register PNG decoder
     "os"
     "path/filepath"
+    "sort"
+    "strings"
 
     "github.com/hajimehoshi/ebiten/v2"
+
+    "github.com/JacobCromwell/Mazenasium/internal/game/logging"
+    "github.com/JacobCromwell/Mazenasium/internal/game/maze"
 )
 
+// DefaultTileTypeImages is the themed art shown for special tile types.
+// Tile types without an entry use the tile's own flavor image. No themed art
+// ships with the game, so it starts empty; add some with RegisterTileTypeImage
+var DefaultTileTypeImages = map[maze.TileType]string{}
+
+// DefaultWallTexture is the brick image textured walls are drawn with,
+// relative to the directory the game runs from
+const DefaultWallTexture = "assets/wall/1.jpg"
+
+// SupportedExtensions are the image file extensions flavor art can use,
+// one for each registered decoder
+var SupportedExtensions = map[string]bool{
+    ".jpg":  true,
+    ".jpeg": true,
+    ".png":  true,
+    ".gif":  true,
+}
+
+// IsSupportedImage checks if the file extension belongs to a supported image format
+func IsSupportedImage(path string) bool {
+    return SupportedExtensions[strings.ToLower(filepath.Ext(path))]
+}
+
 type Manager struct {
-    Images       map[string]*ebiten.Image
-    CurrentImage *ebiten.Image
-    ImageKeys    []string // To allow cycling through images
-    CurrentIndex int
+    Images         map[string]*ebiten.Image
+    CurrentImage   *ebiten.Image
+    ImageKeys      []string // To allow cycling through images
+    CurrentIndex   int
+    TileTypeImages map[maze.TileType]string // Image path registered for each tile type
+    Clips          map[string]*Clip         // Animations keyed by the path of their first frame
+    CurrentClip    *Clip                    // Animation playing in place of CurrentImage, nil for a still
+    Logger         logging.Logger           // Where loading problems are reported
+    
+    failed map[string]error // Paths that couldn't be loaded, so they aren't tried again
 }
 
 func NewManager() *Manager {
+    tileTypeImages := make(map[maze.TileType]string)
+    for tileType, path := range DefaultTileTypeImages {
+        tileTypeImages[tileType] = path
+    }
+    
     return &Manager{
-        Images:       make(map[string]*ebiten.Image),
-        ImageKeys:    make([]string, 0),
-        CurrentIndex: 0,
+        Images:         make(map[string]*ebiten.Image),
+        ImageKeys:      make([]string, 0),
+        CurrentIndex:   0,
+        TileTypeImages: tileTypeImages,
+        Clips:          make(map[string]*Clip),
+        Logger:         logging.Default(),
+        failed:         make(map[string]error),
+    }
+}
+
+// logger returns the manager's logger, or the default one if none was set
+func (m *Manager) logger() logging.Logger {
+    if m == nil || m.Logger == nil {
+        return logging.Default()
+    }
+    return m.Logger
+}
+
+// RegisterTileTypeImage sets the image shown when standing on the given tile type
+func (m *Manager) RegisterTileTypeImage(tileType maze.TileType, path string) {
+    if m.TileTypeImages == nil {
+        m.TileTypeImages = make(map[maze.TileType]string)
+    }
+    m.TileTypeImages[tileType] = path
+}
+
+// ImagePathForTileType returns the image to show for a tile of the given type,
+// falling back to the tile's own flavor image when no type image is registered
+func (m *Manager) ImagePathForTileType(tileType maze.TileType, tilePath string) string {
+    if path, ok := m.TileTypeImages[tileType]; ok && path != "" {
+        return path
+    }
+    return tilePath
+}
+
+// SetImageForTileType shows the registered image for the tile type the player
+// is standing on. If that image can't be loaded the tile's own image is used
+func (m *Manager) SetImageForTileType(tileType maze.TileType, tilePath string) {
+    if m == nil {
+        return
+    }
+    
+    path := m.ImagePathForTileType(tileType, tilePath)
+    if path != tilePath {
+        if img, err := m.loadImage(path); err == nil {
+            m.setCurrent(path, img)
+            return
+        }
+    }
+    
+    if tilePath != "" {
+        m.SetImageByPath(tilePath)
     }
 }
 
@@ -43,13 +133,13 @@ func (m *Manager) LoadImages(assetsDir string) error {
     
     // Create the directory if it doesn't exist
     if _, err := os.Stat(hallwayDir); os.IsNotExist(err) {
-        fmt.Println("Creating hallway directory:", hallwayDir)
+        m.logger().Info("creating hallway directory", "dir", hallwayDir)
         if err := os.MkdirAll(hallwayDir, 0755); err != nil {
             return fmt.Errorf("failed to create hallway directory: %v", err)
         }
         
         // Return early since the directory is empty
-        fmt.Println("Hallway directory is empty, no images to load")
+        m.logger().Info("hallway directory is empty, no images to load", "dir", hallwayDir)
         return nil
     }
     
@@ -62,8 +152,40 @@ func (m *Manager) LoadImages(assetsDir string) error {
         m.ImageKeys = make([]string, 0)
     }
     
+    entries, err := os.ReadDir(hallwayDir)
+    if err != nil {
+        return fmt.Errorf("failed to read hallway directory: %v", err)
+    }
+    
+    // Load every supported image in a stable order. A file that fails to
+    // decode is skipped so the rest still load
+    names := []string{}
+    for _, entry := range entries {
+        if !entry.IsDir() && IsSupportedImage(entry.Name()) {
+            names = append(names, entry.Name())
+        }
+    }
+    
+    // Numbered files such as anim_0.png, anim_1.png play as one animation
+    clips, stills := groupClipFrames(names)
+    for _, name := range stills {
+        if _, err := m.loadImage(filepath.Join(hallwayDir, name)); err != nil {
+            m.logger().Warn("skipping flavor image", "err", err)
+        }
+    }
+    
+    clipKeys := make([]string, 0, len(clips))
+    for key := range clips {
+        clipKeys = append(clipKeys, key)
+    }
+    sort.Strings(clipKeys)
+    for _, key := range clipKeys {
+        if err := m.loadClip(hallwayDir, clips[key]); err != nil {
+            m.logger().Warn("skipping flavor animation", "err", err)
+        }
+    }
+    
 	return nil
-    // Rest of the method...
 }
 
 func (m *Manager) UpdateImage(playerX, playerY int) {
@@ -76,12 +198,56 @@ func (m *Manager) UpdateImage(playerX, playerY int) {
     
     if len(m.ImageKeys) > 0 {
         m.CurrentIndex = index
-        m.CurrentImage = m.Images[m.ImageKeys[index]]
+        m.setCurrent(m.ImageKeys[index], m.Images[m.ImageKeys[index]])
+    }
+}
+
+// Next shows the loaded image after the current one, wrapping around to the
+// first. Does nothing when no images are loaded
+func (m *Manager) Next() {
+    m.step(1)
+}
+
+// Prev shows the loaded image before the current one, wrapping around to the
+// last. Does nothing when no images are loaded
+func (m *Manager) Prev() {
+    m.step(-1)
+}
+
+// step moves CurrentIndex by delta through ImageKeys and shows that image
+func (m *Manager) step(delta int) {
+    if m == nil || len(m.ImageKeys) == 0 {
+        return
+    }
+    
+    index := (m.CurrentIndex + delta) % len(m.ImageKeys)
+    if index < 0 {
+        index += len(m.ImageKeys)
+    }
+    m.CurrentIndex = index
+    m.setCurrent(m.ImageKeys[index], m.Images[m.ImageKeys[index]])
+}
+
+// Update advances the current animation by deltaTime seconds
+func (m *Manager) Update(deltaTime float64) {
+    if m == nil {
+        return
+    }
+    m.CurrentClip.Update(deltaTime)
+}
+
+// currentFrame returns the image to draw: the clip's frame when one is
+// playing, otherwise the still image
+func (m *Manager) currentFrame() *ebiten.Image {
+    if frame := m.CurrentClip.Frame(); frame != nil {
+        return frame
     }
+    return m.CurrentImage
 }
 
 func (m *Manager) Draw(screen *ebiten.Image, x, y, width, height int) {
-    if m.CurrentImage == nil {
+    img := m.currentFrame()
+    if img == nil {
         return
     }
     
@@ -89,7 +255,7 @@ func (m *Manager) Draw(screen *ebiten.Image, x, y, width, height int) {
     op := &ebiten.DrawImageOptions{}
     
     // Scale image to fit the section while maintaining aspect ratio
-    imgWidth, imgHeight := m.CurrentImage.Size()
+    imgWidth, imgHeight := img.Size()
     scaleX := float64(width) / float64(imgWidth)
     scaleY := float64(height) / float64(imgHeight)
     
@@ -107,44 +273,114 @@ func (m *Manager) Draw(screen *ebiten.Image, x, y, width, height int) {
     
     op.GeoM.Translate(float64(centeredX), float64(centeredY))
     
-    screen.DrawImage(m.CurrentImage, op)
+    screen.DrawImage(img, op)
 }
 
 // Update in internal/game/flavor/flavor.go
 func (m *Manager) SetImageByPath(path string) {
     // Safety check
     if m == nil || m.Images == nil {
-        fmt.Println("Warning: Flavor manager or images map is nil")
+        m.logger().Warn("flavor manager or images map is nil")
         return
     }
     
-    // Check if the image is already loaded
-    img, exists := m.Images[path]
-    if exists {
-        m.CurrentImage = img
+    // Only report a broken image the first time it is asked for
+    _, failedBefore := m.failed[path]
+    img, err := m.loadImage(path)
+    if err != nil {
+        if !failedBefore {
+            m.logger().Warn("could not show flavor image", "err", err)
+        }
         return
     }
     
-    // If the image isn't loaded yet, try to load it
+    m.setCurrent(path, img)
+}
+
+// setCurrent shows the image loaded from path, starting its animation from
+// the first frame if the path is the start of a clip
+func (m *Manager) setCurrent(path string, img *ebiten.Image) {
+    clip := m.Clips[path]
+    if clip != nil && clip != m.CurrentClip {
+        clip.Reset()
+    }
+    m.CurrentImage = img
+    m.CurrentClip = clip
+}
+
+// loadClip loads the numbered frames in dir as one animation. The first frame
+// is cached like a still so the clip takes part in image cycling
+func (m *Manager) loadClip(dir string, names []string) error {
+    firstPath := filepath.Join(dir, names[0])
+    first, err := m.loadImage(firstPath)
+    if err != nil {
+        return err
+    }
+    
+    frames := []*ebiten.Image{first}
+    for _, name := range names[1:] {
+        frame, err := decodeImage(filepath.Join(dir, name))
+        if err != nil {
+            return err
+        }
+        frames = append(frames, frame)
+    }
+    
+    if m.Clips == nil {
+        m.Clips = make(map[string]*Clip)
+    }
+    m.Clips[firstPath] = NewClip(frames, DefaultFrameDuration)
+    return nil
+}
+
+// loadImage returns the image at path, loading and caching it on first use.
+// A path that fails to load keeps returning the same error without being retried
+func (m *Manager) loadImage(path string) (*ebiten.Image, error) {
+    // Check if the image is already loaded, or already known to be broken
+    if img, exists := m.Images[path]; exists {
+        return img, nil
+    }
+    if err, failed := m.failed[path]; failed {
+        return nil, err
+    }
+    
+    ebitenImg, err := decodeImage(path)
+    if err != nil {
+        if m.failed == nil {
+            m.failed = make(map[string]error)
+        }
+        m.failed[path] = err
+        return nil, err
+    }
+    m.Images[path] = ebitenImg
+    m.ImageKeys = append(m.ImageKeys, path)
+    
+    return ebitenImg, nil
+}
+
+// LoadTexture loads an image used to draw the maze itself, such as the
+// wall texture, from a supported image file
+func LoadTexture(path string) (*ebiten.Image, error) {
+    return decodeImage(path)
+}
+
+// decodeImage reads and decodes the image file at path
+func decodeImage(path string) (*ebiten.Image, error) {
+    if !IsSupportedImage(path) {
+        return nil, fmt.Errorf("unsupported image format %s", path)
+    }
+    
     file, err := os.Open(path)
     if err != nil {
-        fmt.Printf("Warning: Could not open image %s: %v\n", path, err)
-        return
+        return nil, fmt.Errorf("could not open image %s: %v", path, err)
     }
     defer file.Close()
     
     // Decode image
     decodedImg, _, err := image.Decode(file)
     if err != nil {
-        fmt.Printf("Warning: Could not decode image %s: %v\n", path, err)
-        return
+        return nil, fmt.Errorf("could not decode image %s: %v", path, err)
     }
     
-    // Convert to ebiten image
-    ebitenImg := ebiten.NewImageFromImage(decodedImg)
-    
-    // Store image and set as current
-    m.Images[path] = ebitenImg
-    m.ImageKeys = append(m.ImageKeys, path)
-    m.CurrentImage = ebitenImg
+    return ebiten.NewImageFromImage(decodedImg), nil
 }
\ No newline at end of file
@@ -0,0 +1,229 @@
+// internal/game/flavor/flavor_test.go
+package flavor
+
+import (
+    "image"
+    "image/jpeg"
+    "image/png"
+    "os"
+    "path/filepath"
+    "testing"
+
+    "github.com/JacobCromwell/Mazenasium/internal/game/logging"
+    "github.com/JacobCromwell/Mazenasium/internal/game/maze"
+)
+
+// writePNG saves a small blank PNG at path
+func writePNG(t *testing.T, path string) {
+    t.Helper()
+    file, err := os.Create(path)
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer file.Close()
+    if err := png.Encode(file, image.NewRGBA(image.Rect(0, 0, 4, 4))); err != nil {
+        t.Fatal(err)
+    }
+}
+
+// writeJPEG saves a small blank JPEG at path
+func writeJPEG(t *testing.T, path string) {
+    t.Helper()
+    file, err := os.Create(path)
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer file.Close()
+    if err := jpeg.Encode(file, image.NewRGBA(image.Rect(0, 0, 4, 4)), nil); err != nil {
+        t.Fatal(err)
+    }
+}
+
+// warnRecorder keeps the warnings logged to it and drops everything else
+type warnRecorder struct {
+    logging.Nop
+    warnings []string
+}
+
+func (r *warnRecorder) Warn(msg string, args ...any) {
+    r.warnings = append(r.warnings, msg)
+}
+
+// newTestManager creates a flavor manager that doesn't report problems
+func newTestManager() *Manager {
+    m := NewManager()
+    m.Logger = logging.Nop{}
+    return m
+}
+
+func TestSpecialTileSelectsRegisteredImage(t *testing.T) {
+    dir := t.TempDir()
+    hallway := filepath.Join(dir, "hallway.png")
+    special := filepath.Join(dir, "special.png")
+    writePNG(t, hallway)
+    writePNG(t, special)
+
+    m := newTestManager()
+    m.RegisterTileTypeImage(maze.SpecialTrigger, special)
+
+    // Standing on a special tile next to the goal shows the special art
+    m.SetImageForTileType(maze.SpecialTrigger, hallway)
+    if m.CurrentImage == nil || m.CurrentImage != m.Images[special] {
+        t.Error("the special tile should show the image registered for its type")
+    }
+
+    // A plain floor tile keeps its own image
+    m.SetImageForTileType(maze.Floor, hallway)
+    if m.CurrentImage == nil || m.CurrentImage != m.Images[hallway] {
+        t.Error("a floor tile should show its own flavor image")
+    }
+}
+
+func TestDefaultsUseTheTilesOwnImage(t *testing.T) {
+    m := newTestManager()
+    for _, tileType := range []maze.TileType{maze.Goal, maze.Trap, maze.SpecialTrigger} {
+        if got := m.ImagePathForTileType(tileType, "hallway.png"); got != "hallway.png" {
+            t.Errorf("%v tiles show %q by default, want the tile's own image", tileType, got)
+        }
+    }
+}
+
+func TestFailedImageIsNotRetried(t *testing.T) {
+    dir := t.TempDir()
+    hallway := filepath.Join(dir, "hallway.png")
+    missing := filepath.Join(dir, "trap.png")
+    writePNG(t, hallway)
+
+    m := newTestManager()
+    m.RegisterTileTypeImage(maze.Trap, missing)
+    m.SetImageForTileType(maze.Trap, hallway)
+    if m.CurrentImage != m.Images[hallway] {
+        t.Fatal("a missing type image should fall back to the tile's own image")
+    }
+
+    // Once the path has failed, it isn't loaded even after the file appears
+    writePNG(t, missing)
+    m.SetImageForTileType(maze.Trap, hallway)
+    if _, loaded := m.Images[missing]; loaded {
+        t.Error("a path that failed to load was tried again")
+    }
+    if m.CurrentImage != m.Images[hallway] {
+        t.Error("the tile's own image should still be shown")
+    }
+}
+
+func TestLoadImagesReadsPNGAndJPEG(t *testing.T) {
+    dir := t.TempDir()
+    hallway := filepath.Join(dir, "hallway")
+    if err := os.Mkdir(hallway, 0755); err != nil {
+        t.Fatal(err)
+    }
+    writePNG(t, filepath.Join(hallway, "corridor.png"))
+    writeJPEG(t, filepath.Join(hallway, "lobby.jpg"))
+    if err := os.WriteFile(filepath.Join(hallway, "notes.txt"), []byte("not an image"), 0644); err != nil {
+        t.Fatal(err)
+    }
+    if err := os.WriteFile(filepath.Join(hallway, "broken.png"), []byte("not a png"), 0644); err != nil {
+        t.Fatal(err)
+    }
+    
+    m := newTestManager()
+    if err := m.LoadImages(dir); err != nil {
+        t.Fatalf("LoadImages() = %v", err)
+    }
+    
+    for _, name := range []string{"corridor.png", "lobby.jpg"} {
+        if m.Images[filepath.Join(hallway, name)] == nil {
+            t.Errorf("%s wasn't loaded", name)
+        }
+    }
+    if len(m.Images) != 2 {
+        t.Errorf("%d images loaded, want only the PNG and the JPEG", len(m.Images))
+    }
+}
+
+func TestWarningsGoToTheInjectedLogger(t *testing.T) {
+    dir := t.TempDir()
+    broken := filepath.Join(dir, "broken.png")
+    if err := os.WriteFile(broken, []byte("not a png"), 0644); err != nil {
+        t.Fatal(err)
+    }
+    
+    rec := &warnRecorder{}
+    m := NewManager()
+    m.Logger = rec
+    m.SetImageByPath(broken)
+    m.SetImageByPath(broken)
+    
+    if len(rec.warnings) != 1 || rec.warnings[0] != "could not show flavor image" {
+        t.Errorf("warnings = %q, want the broken image reported once", rec.warnings)
+    }
+}
+
+// loadedManager creates a manager with count images loaded from a scratch
+// hallway directory, in name order
+func loadedManager(t *testing.T, count int) *Manager {
+    t.Helper()
+    dir := t.TempDir()
+    hallway := filepath.Join(dir, "hallway")
+    if err := os.Mkdir(hallway, 0755); err != nil {
+        t.Fatal(err)
+    }
+    for i := 0; i < count; i++ {
+        writePNG(t, filepath.Join(hallway, string(rune('a'+i))+".png"))
+    }
+    m := newTestManager()
+    if err := m.LoadImages(dir); err != nil {
+        t.Fatal(err)
+    }
+    if len(m.ImageKeys) != count {
+        t.Fatalf("%d images loaded, want %d", len(m.ImageKeys), count)
+    }
+    return m
+}
+
+func TestNextAdvancesAndWraps(t *testing.T) {
+    m := loadedManager(t, 3)
+    m.CurrentIndex = 0
+    
+    for _, want := range []int{1, 2, 0} {
+        m.Next()
+        if m.CurrentIndex != want {
+            t.Fatalf("CurrentIndex = %d after Next, want %d", m.CurrentIndex, want)
+        }
+        if m.CurrentImage != m.Images[m.ImageKeys[want]] {
+            t.Errorf("image %d isn't shown after Next", want)
+        }
+    }
+}
+
+func TestPrevFromTheFirstGoesToTheLast(t *testing.T) {
+    m := loadedManager(t, 3)
+    m.CurrentIndex = 0
+    
+    m.Prev()
+    if m.CurrentIndex != 2 || m.CurrentImage != m.Images[m.ImageKeys[2]] {
+        t.Errorf("CurrentIndex = %d after Prev from the first, want the last (2) shown", m.CurrentIndex)
+    }
+}
+
+func TestCyclingWithNoImages(t *testing.T) {
+    m := newTestManager()
+    m.Next()
+    m.Prev()
+    if m.CurrentIndex != 0 || m.CurrentImage != nil {
+        t.Errorf("cycling with no images changed index %d, image %v", m.CurrentIndex, m.CurrentImage)
+    }
+}
+
+func TestShippedWallTextureLoads(t *testing.T) {
+    // The game runs from cmd/game, where the assets directory lives
+    path := filepath.Join("..", "..", "..", "cmd", "game", DefaultWallTexture)
+    texture, err := LoadTexture(path)
+    if err != nil {
+        t.Fatalf("LoadTexture(%q) failed: %v", path, err)
+    }
+    if w, h := texture.Bounds().Dx(), texture.Bounds().Dy(); w == 0 || h == 0 {
+        t.Errorf("wall texture is %dx%d, want a drawable image", w, h)
+    }
+}
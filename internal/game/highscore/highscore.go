@@ -0,0 +1,100 @@
+// internal/game/highscore/highscore.go
+package highscore
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// DefaultPath is where best results are stored between sessions
+const DefaultPath = "highscores.json"
+
+// Record is a single completed run
+type Record struct {
+	Turns   int     `json:"turns"`
+	Seconds float64 `json:"seconds"`
+}
+
+// BetterThan checks if this run beats another: fewer turns wins,
+// and a faster time breaks ties
+func (r Record) BetterThan(other Record) bool {
+	if r.Turns != other.Turns {
+		return r.Turns < other.Turns
+	}
+	return r.Seconds < other.Seconds
+}
+
+// Table holds the best record for each maze size category
+type Table struct {
+	Best map[string]Record `json:"best"`
+}
+
+// NewTable creates an empty high score table
+func NewTable() *Table {
+	return &Table{
+		Best: make(map[string]Record),
+	}
+}
+
+// Category returns the key used to group records by maze size
+func Category(width, height int) string {
+	return fmt.Sprintf("%dx%d", width, height)
+}
+
+// Lookup returns the best record for a category, if one exists
+func (t *Table) Lookup(category string) (Record, bool) {
+	record, ok := t.Best[category]
+	return record, ok
+}
+
+// Submit stores the record if it's the first for its category or beats the
+// current best. Returns true if the record was stored
+func (t *Table) Submit(category string, record Record) bool {
+	if t.Best == nil {
+		t.Best = make(map[string]Record)
+	}
+
+	if best, ok := t.Best[category]; ok && !record.BetterThan(best) {
+		return false
+	}
+
+	t.Best[category] = record
+	return true
+}
+
+// Load reads a high score table from disk.
+// A missing file is not an error; it simply yields an empty table
+func Load(path string) (*Table, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return NewTable(), nil
+	}
+	if err != nil {
+		return NewTable(), fmt.Errorf("failed to read high scores: %v", err)
+	}
+
+	table := NewTable()
+	if err := json.Unmarshal(data, table); err != nil {
+		return NewTable(), fmt.Errorf("failed to parse high scores: %v", err)
+	}
+	if table.Best == nil {
+		table.Best = make(map[string]Record)
+	}
+
+	return table, nil
+}
+
+// Save writes a high score table to disk
+func Save(path string, table *Table) error {
+	data, err := json.MarshalIndent(table, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode high scores: %v", err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write high scores: %v", err)
+	}
+
+	return nil
+}
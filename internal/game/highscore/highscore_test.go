@@ -0,0 +1,82 @@
+// internal/game/highscore/highscore_test.go
+package highscore
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestSubmitFirstRecord(t *testing.T) {
+	table := NewTable()
+	record := Record{Turns: 12, Seconds: 40}
+
+	if !table.Submit("20x20", record) {
+		t.Fatal("the first record for a category should always be stored")
+	}
+	if best, ok := table.Lookup("20x20"); !ok || best != record {
+		t.Errorf("Lookup() = %v, %v, want %v", best, ok, record)
+	}
+	if _, ok := table.Lookup("30x30"); ok {
+		t.Error("other categories should still be empty")
+	}
+}
+
+func TestSubmitOnlyKeepsBetterRecords(t *testing.T) {
+	table := NewTable()
+	best := Record{Turns: 12, Seconds: 40}
+	table.Submit("20x20", best)
+
+	worse := []Record{
+		{Turns: 13, Seconds: 10}, // More turns loses even when faster
+		{Turns: 12, Seconds: 45}, // Same turns, slower
+		{Turns: 12, Seconds: 40}, // A tie doesn't replace the record
+	}
+	for _, record := range worse {
+		if table.Submit("20x20", record) {
+			t.Errorf("%v replaced the better %v", record, best)
+		}
+	}
+	if got, _ := table.Lookup("20x20"); got != best {
+		t.Fatalf("best = %v, want %v", got, best)
+	}
+
+	better := []Record{
+		{Turns: 12, Seconds: 30}, // Same turns, faster
+		{Turns: 10, Seconds: 90}, // Fewer turns wins even when slower
+	}
+	for _, record := range better {
+		if !table.Submit("20x20", record) {
+			t.Errorf("%v should have beaten the stored record", record)
+		}
+		if got, _ := table.Lookup("20x20"); got != record {
+			t.Errorf("best = %v, want %v", got, record)
+		}
+	}
+}
+
+func TestSaveAndLoadRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "scores.json")
+	table := NewTable()
+	table.Submit(Category(20, 20), Record{Turns: 8, Seconds: 21.5})
+
+	if err := Save(path, table); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := loaded.Lookup("20x20"); got != (Record{Turns: 8, Seconds: 21.5}) {
+		t.Errorf("loaded record = %v", got)
+	}
+}
+
+func TestLoadMissingFileIsEmpty(t *testing.T) {
+	table, err := Load(filepath.Join(t.TempDir(), "missing.json"))
+	if err != nil {
+		t.Fatalf("Load() error = %v, want none for a missing file", err)
+	}
+	if len(table.Best) != 0 {
+		t.Errorf("table has %d records, want an empty one", len(table.Best))
+	}
+}
@@ -0,0 +1,54 @@
+// internal/game/logging/logging.go
+package logging
+
+import (
+	"log/slog"
+	"os"
+	"sync"
+)
+
+// Logger receives the game's diagnostic messages. Arguments after the
+// message are alternating keys and values, as with log/slog.
+// *slog.Logger satisfies it
+type Logger interface {
+	Debug(msg string, args ...any)
+	Info(msg string, args ...any)
+	Warn(msg string, args ...any)
+	Error(msg string, args ...any)
+}
+
+// Nop discards everything, for tests and silent runs
+type Nop struct{}
+
+func (Nop) Debug(msg string, args ...any) {}
+func (Nop) Info(msg string, args ...any)  {}
+func (Nop) Warn(msg string, args ...any)  {}
+func (Nop) Error(msg string, args ...any) {}
+
+// NewStd creates a logger writing text records to stderr
+func NewStd() Logger {
+	return slog.New(slog.NewTextHandler(os.Stderr, nil))
+}
+
+var (
+	mu            sync.RWMutex
+	defaultLogger = NewStd()
+)
+
+// Default returns the logger components use when none is given to them
+func Default() Logger {
+	mu.RLock()
+	defer mu.RUnlock()
+	return defaultLogger
+}
+
+// SetDefault replaces the logger returned by Default, nil restores the
+// stderr logger. Components pick it up when they are created
+func SetDefault(logger Logger) {
+	if logger == nil {
+		logger = NewStd()
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	defaultLogger = logger
+}
@@ -0,0 +1,40 @@
+// internal/game/logging/logging_test.go
+package logging
+
+import "testing"
+
+// recorder keeps the messages logged at each level
+type recorder struct {
+	warnings []string
+	others   []string
+}
+
+func (r *recorder) Debug(msg string, args ...any) { r.others = append(r.others, msg) }
+func (r *recorder) Info(msg string, args ...any)  { r.others = append(r.others, msg) }
+func (r *recorder) Warn(msg string, args ...any)  { r.warnings = append(r.warnings, msg) }
+func (r *recorder) Error(msg string, args ...any) { r.others = append(r.others, msg) }
+
+func TestSetDefaultRoutesWarnings(t *testing.T) {
+	rec := &recorder{}
+	SetDefault(rec)
+	defer SetDefault(nil)
+
+	Default().Warn("maze too small")
+	if len(rec.warnings) != 1 || rec.warnings[0] != "maze too small" || len(rec.others) != 0 {
+		t.Errorf("warnings %v, others %v, want only the one warning", rec.warnings, rec.others)
+	}
+}
+
+func TestSetDefaultNilRestoresStderr(t *testing.T) {
+	rec := &recorder{}
+	SetDefault(rec)
+	SetDefault(nil)
+
+	if Default() == Logger(rec) {
+		t.Fatal("the injected logger is still the default")
+	}
+	Default().Warn("goes to stderr")
+	if len(rec.warnings) != 0 {
+		t.Errorf("the replaced logger still got %v", rec.warnings)
+	}
+}
@@ -3,5 +3,6 @@ package maze
 
 // Constants used by maze package
 const (
-    TileSize = 30  // Size of each tile in the maze in pixels
+    TileSize               = 30 // Default size of each tile in the maze in pixels
+    DefaultBorderThickness = 1  // Wall frame around the maze, in tiles
 )
\ No newline at end of file
@@ -0,0 +1,96 @@
+// internal/game/maze/corner.go
+package maze
+
+// Corner picks one of the four corners of the maze
+type Corner int
+
+const (
+    OppositeCorner    Corner = iota // Diagonally across from the start; as a start it keeps Generator.Start
+    TopLeftCorner
+    TopRightCorner
+    BottomLeftCorner
+    BottomRightCorner
+)
+
+// String returns a display name for the corner option
+func (c Corner) String() string {
+    switch c {
+    case TopLeftCorner:
+        return "Top Left"
+    case TopRightCorner:
+        return "Top Right"
+    case BottomLeftCorner:
+        return "Bottom Left"
+    case BottomRightCorner:
+        return "Bottom Right"
+    default:
+        return "Opposite"
+    }
+}
+
+// Next cycles to the following corner option
+func (c Corner) Next() Corner {
+    return (c + 1) % 5
+}
+
+// NextStart cycles to the following corner a start can use,
+// skipping OppositeCorner which only makes sense for the goal
+func (c Corner) NextStart() Corner {
+    if next := c.Next(); next != OppositeCorner {
+        return next
+    }
+    return TopLeftCorner
+}
+
+// Opposite returns the corner diagonally across from this one
+func (c Corner) Opposite() Corner {
+    switch c {
+    case TopLeftCorner:
+        return BottomRightCorner
+    case TopRightCorner:
+        return BottomLeftCorner
+    case BottomLeftCorner:
+        return TopRightCorner
+    case BottomRightCorner:
+        return TopLeftCorner
+    default:
+        return OppositeCorner
+    }
+}
+
+// IsRight checks if the corner lies on the right edge
+func (c Corner) IsRight() bool {
+    return c == TopRightCorner || c == BottomRightCorner
+}
+
+// IsBottom checks if the corner lies on the bottom edge
+func (c Corner) IsBottom() bool {
+    return c == BottomLeftCorner || c == BottomRightCorner
+}
+
+// Cell returns the first interior cell in this corner of the grid
+func (c Corner) Cell(s *State) Position {
+    cell := Position{X: s.Border, Y: s.Border}
+    if c.IsRight() {
+        cell.X = s.Width - 1 - s.Border
+    }
+    if c.IsBottom() {
+        cell.Y = s.Height - 1 - s.Border
+    }
+    return cell
+}
+
+// CornerOf returns the corner whose quarter of the grid contains p
+func CornerOf(p Position, width, height int) Corner {
+    right, bottom := p.X >= width/2, p.Y >= height/2
+    switch {
+    case right && bottom:
+        return BottomRightCorner
+    case right:
+        return TopRightCorner
+    case bottom:
+        return BottomLeftCorner
+    default:
+        return TopLeftCorner
+    }
+}
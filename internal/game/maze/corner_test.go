@@ -0,0 +1,36 @@
+// internal/game/maze/corner_test.go
+package maze
+
+import "testing"
+
+func TestStartAndGoalLandInRequestedCorners(t *testing.T) {
+    starts := []Corner{TopLeftCorner, TopRightCorner, BottomLeftCorner, BottomRightCorner}
+    goals := append([]Corner{OppositeCorner}, starts...)
+    
+    for _, startCorner := range starts {
+        for _, goalCorner := range goals {
+            // The goal can't share the start's quarter, so it goes across instead
+            want := goalCorner
+            if want == OppositeCorner || want == startCorner {
+                want = startCorner.Opposite()
+            }
+            
+            for seed := int64(1); seed <= 5; seed++ {
+                g := newTestGenerator(seed)
+                g.StartCorner, g.GoalCorner = startCorner, goalCorner
+                state := g.Generate(21, 17)
+                
+                if state.Start != startCorner.Cell(state) {
+                    t.Errorf("%v/%v seed %d: start at %v, want %v", startCorner, goalCorner, seed, state.Start, startCorner.Cell(state))
+                }
+                goal := Position{X: state.GoalX, Y: state.GoalY}
+                if got := CornerOf(goal, state.Width, state.Height); got != want {
+                    t.Errorf("%v/%v seed %d: goal %v is in the %v quarter, want %v", startCorner, goalCorner, seed, goal, got, want)
+                }
+                if !g.hasPath(state, state.Start.X, state.Start.Y, goal.X, goal.Y) {
+                    t.Errorf("%v/%v seed %d: the start can't reach the goal", startCorner, goalCorner, seed)
+                }
+            }
+        }
+    }
+}
@@ -0,0 +1,103 @@
+// internal/game/maze/difficulty.go
+package maze
+
+import (
+    "math"
+)
+
+// MaxDifficulty is the top of the difficulty scale
+const MaxDifficulty = 10.0
+
+// Weights of each structural measure in the difficulty rating. They add up to 1
+const (
+    pathWeight     = 0.5 // Length of the solution compared to a straight run
+    deadEndWeight  = 0.3 // Share of the floor that is a dead end
+    branchWeight   = 0.1 // Decision points along the solution
+    distanceWeight = 0.1 // How far apart the start and goal are
+)
+
+// Difficulty rates how hard the maze is to solve from the start, from 0 to
+// MaxDifficulty. Long winding solutions with many dead ends score highest,
+// short trips across open floor lowest. Returns 0 if the goal can't be reached
+func (g *Generator) Difficulty(state *State) float64 {
+    goal, ok := state.FindGoal()
+    if !ok {
+        return 0
+    }
+    path := state.ShortestPath(state.Start, goal)
+    floor := state.FloorTileCount()
+    if path == nil || floor == 0 {
+        return 0
+    }
+    span := float64(state.Width + state.Height)
+    
+    // A winding solution up to three times a straight run scores fully
+    pathScore := math.Min(float64(len(path)-1)/span/3, 1)
+    
+    // Perfect mazes leave roughly a tenth of their floor as dead ends
+    deadEndScore := math.Min(float64(state.DeadEnds())/float64(floor)*10, 1)
+    
+    // Junctions passed on the way, each one a chance to take a wrong turn.
+    // Junctions in open rooms aren't real choices, so openness discounts them
+    branches := 0
+    for _, cell := range path {
+        if state.walkableNeighbours(cell) >= 3 {
+            branches++
+        }
+    }
+    branchScore := math.Min(float64(branches)/float64(len(path)), 1) * (1 - state.openness())
+    
+    distanceScore := math.Min(float64(abs(goal.X-state.Start.X)+abs(goal.Y-state.Start.Y))/span, 1)
+    
+    return MaxDifficulty * (pathWeight*pathScore + deadEndWeight*deadEndScore +
+        branchWeight*branchScore + distanceWeight*distanceScore)
+}
+
+// Difficulty rates how hard the maze is to solve, see Generator.Difficulty
+func (m *Maze) Difficulty() float64 {
+    return m.Generator.Difficulty(m.State)
+}
+
+// DeadEnds counts the walkable tiles with only one walkable neighbour
+func (s *State) DeadEnds() int {
+    count := 0
+    for y := 0; y < s.Height; y++ {
+        for x := 0; x < s.Width; x++ {
+            if s.IsValidMove(x, y) && s.walkableNeighbours(Position{X: x, Y: y}) == 1 {
+                count++
+            }
+        }
+    }
+    return count
+}
+
+// openness returns the share of walkable tiles with no wall on any side
+func (s *State) openness() float64 {
+    open, floor := 0, 0
+    for y := 0; y < s.Height; y++ {
+        for x := 0; x < s.Width; x++ {
+            if !s.IsValidMove(x, y) {
+                continue
+            }
+            floor++
+            if s.walkableNeighbours(Position{X: x, Y: y}) == 4 {
+                open++
+            }
+        }
+    }
+    if floor == 0 {
+        return 0
+    }
+    return float64(open) / float64(floor)
+}
+
+// walkableNeighbours counts the orthogonal neighbours of a cell that aren't walls
+func (s *State) walkableNeighbours(p Position) int {
+    count := 0
+    for _, offset := range []Position{{X: 0, Y: -1}, {X: 1, Y: 0}, {X: 0, Y: 1}, {X: -1, Y: 0}} {
+        if s.IsValidMove(p.X+offset.X, p.Y+offset.Y) {
+            count++
+        }
+    }
+    return count
+}
@@ -0,0 +1,52 @@
+// internal/game/maze/difficulty_test.go
+package maze
+
+import "testing"
+
+func TestWindingMazeRatesHarderThanOpenRoom(t *testing.T) {
+    open := parseGrid(
+        "#########",
+        "#.......#",
+        "#.......#",
+        "#..G....#",
+        "#.......#",
+        "#.......#",
+        "#########",
+    )
+    open.Start = Position{X: 1, Y: 1}
+    
+    winding := parseGrid(
+        "#########",
+        "#.....#.#",
+        "#####.#.#",
+        "#.....#.#",
+        "#.#.#####",
+        "#......G#",
+        "#########",
+    )
+    winding.Start = Position{X: 1, Y: 1}
+    
+    g := newTestGenerator(1)
+    easy, hard := g.Difficulty(open), g.Difficulty(winding)
+    if hard <= easy {
+        t.Errorf("winding maze rated %.2f, open room %.2f, want the winding maze harder", hard, easy)
+    }
+    for _, rating := range []float64{easy, hard} {
+        if rating <= 0 || rating > MaxDifficulty {
+            t.Errorf("rating %.2f outside (0, %v]", rating, MaxDifficulty)
+        }
+    }
+}
+
+func TestUnreachableGoalRatesZero(t *testing.T) {
+    state := parseGrid(
+        "#######",
+        "#..#.G#",
+        "#######",
+    )
+    state.Start = Position{X: 1, Y: 1}
+    
+    if got := newTestGenerator(1).Difficulty(state); got != 0 {
+        t.Errorf("Difficulty() = %.2f with the goal walled off, want 0", got)
+    }
+}
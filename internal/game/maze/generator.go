@@ -2,49 +2,162 @@ package maze
 
 import (
 	"fmt"
+    "math"
     "math/rand"
+    "time"
+    
+    "github.com/JacobCromwell/Mazenasium/internal/game/logging"
 )
 
 // Generator handles maze generation algorithms
 type Generator struct {
     // Any configuration options for generation
-    RandomSeed int64
+    RandomSeed      int64
+    Start           Position   // Cell carving starts from; the player spawns here
+    SpawnRequests   []Position // Preferred NPC spawn cells, moved to the nearest floor if needed
+    TrapCount       int        // Number of trap tiles scattered on the floor, each mirrored in symmetric mazes
+    TeleporterPairs int        // Number of linked teleporter pairs placed on the floor, each mirrored in symmetric mazes
+    Symmetry        Symmetry   // Optional mirror or rotational symmetry of the layout
+    ExtraPathFactor float64    // How many loops to open up, 0 keeps a perfect maze
+    BorderThickness int        // Wall frame around the maze that is never carved
+    StartCorner     Corner     // Corner the start is moved to, OppositeCorner keeps Start
+    GoalCorner      Corner     // Corner whose quarter holds the goal
+    RoomCount       int        // Rectangular rooms to open up, 0 for corridors only
+    RoomMinSize     int        // Smallest room side in tiles
+    RoomMaxSize     int        // Largest room side in tiles
+    Rooms           []Room     // Rooms carved by the last Generate
+    
+    Logger           logging.Logger // Where generation timings are reported at debug level
+    LastGenerateTime time.Duration  // How long the last Generate took, retries included
+    
+    // Fewest steps, ignoring walls, between NPC spawns and from the start.
+    // Relaxed one step at a time when the maze has no room for it
+    MinSpawnSeparation int
+    
+    rng *rand.Rand // Seeded source for the generation in progress
 }
 
+// DefaultSpawnRequests are the preferred NPC spawn cells
+var DefaultSpawnRequests = []Position{{X: 3, Y: 3}, {X: 5, Y: 5}}
+
+// DefaultMinSpawnSeparation keeps NPCs from starting on top of the player
+// or each other
+const DefaultMinSpawnSeparation = 6
+
+// DefaultTrapCount is the number of traps placed in a new maze. Traps are
+// off unless a count is chosen
+const DefaultTrapCount = 0
+
+// DefaultTeleporterPairs is the number of teleporter pairs placed in a new maze
+const DefaultTeleporterPairs = 1
+
+// DefaultExtraPathFactor opens (Width + Height) / 3 extra paths
+const DefaultExtraPathFactor = 1.0
+
+// MaxExtraPathFactor keeps the maze from turning into an open field
+const MaxExtraPathFactor = 4.0
+
 // NewGenerator creates a new maze generator
 func NewGenerator(seed int64) *Generator {
     return &Generator{
-        RandomSeed: seed,
+        RandomSeed:      seed,
+        Start:           Position{X: 1, Y: 1},
+        SpawnRequests:   append([]Position(nil), DefaultSpawnRequests...),
+        TrapCount:       DefaultTrapCount,
+        TeleporterPairs: DefaultTeleporterPairs,
+        ExtraPathFactor: DefaultExtraPathFactor,
+        BorderThickness: DefaultBorderThickness,
+        RoomMinSize:     DefaultRoomMinSize,
+        RoomMaxSize:     DefaultRoomMaxSize,
+        Logger:          logging.Default(),
     }
 }
 
-// Generate creates a new maze with the given dimensions
+// MaxGenerateAttempts is how many seeds Generate tries before falling back
+// to an open maze
+const MaxGenerateAttempts = 10
+
+// Generate creates a new maze with the given dimensions.
+// If the goal can't be reached from the start, or a symmetric layout came out
+// lopsided, generation is retried with the next seed, and RandomSeed is left on the seed that produced the maze so it
+// can be rebuilt. Should every attempt fail, an open maze is returned instead.
+// The time taken is kept in LastGenerateTime
 func (g *Generator) Generate(width, height int) *State {
+    started := time.Now()
+    defer g.recordGenerateTime(width, height, started)
+    
+    for attempt := 0; attempt < MaxGenerateAttempts; attempt++ {
+        state := g.generate(width, height)
+        if g.hasPath(state, state.Start.X, state.Start.Y, state.GoalX, state.GoalY) &&
+            state.IsSymmetric(g.Symmetry) {
+            return state
+        }
+        g.RandomSeed++
+    }
+    
+    return g.generateOpen(width, height)
+}
+
+// generate carves a maze from the current RandomSeed
+func (g *Generator) generate(width, height int) *State {
     // Create a new empty state
-    state := NewState(width, height)
+    state := NewStateWithBorder(width, height, g.BorderThickness)
     
     // Use a local random source to ensure deterministic generation with the same seed
     r := rand.New(rand.NewSource(g.RandomSeed))
+    g.rng = r
+    
+    // Generate the maze using a depth-first search algorithm.
+    // Symmetric mazes only carve the left half and reflect it afterwards
+    // The start has to lie inside the wall frame
+    start := g.Start
+    if g.StartCorner != OppositeCorner {
+        start = g.StartCorner.Cell(state)
+    } else if !state.InInterior(start.X, start.Y) {
+        start = Position{X: state.Border, Y: state.Border}
+    }
+    carveStart, carveMaxX := start, state.Width-1
+    if g.Symmetry != NoSymmetry {
+        carveMaxX = (state.Width-1)/2 + 1
+        if start.X >= carveMaxX {
+            carveStart = g.Symmetry.Reflect(start, state.Width, state.Height)
+        }
+    }
+    g.generatePathways(state, carveStart.X, carveStart.Y, carveMaxX, r)
+    state.Start = start
     
-    // Generate the maze using a depth-first search algorithm
-    g.generatePathways(state, 1, 1, r)
+    // Open up rooms among the corridors
+    g.carveRooms(state, carveStart, carveMaxX, r)
     
     // Add some random additional paths
     g.addRandomPaths(state, r)
     
-    // Choose a goal position in the bottom-right quarter
+    // Mirror the carved half and make sure both halves are joined
+    if g.Symmetry != NoSymmetry {
+        g.applySymmetry(state)
+        g.reflectRooms(state)
+        g.connectSymmetric(state, start)
+    }
+    
+    // Choose a goal position in the goal corner's quarter. A goal on a
+    // wall opens that wall's reflection too
     goalX, goalY := g.chooseGoalPosition(state, r)
+    g.carveFloor(state, Position{X: goalX, Y: goalY})
     state.SetTileType(goalX, goalY, Goal)
     state.GoalX = goalX
     state.GoalY = goalY
     
-    // Ensure there's a path to the goal
-    g.ensurePathToGoal(state, 1, 1, goalX, goalY)
+    // Resolve the NPC spawns to the nearest free floor tiles
+    g.placeSpawns(state)
+    
+    // Ensure every spawn has a path to the goal
+    g.ValidateReachability(state, append([]Position{start}, state.Spawns...))
+    
+    // Scatter traps on the remaining floor
+    g.placeTraps(state, r)
     
-    // Ensure the starting positions for player and NPCs are clear
-    state.SetTileType(1, 1, Floor) // Player start
-    state.SetTileType(3, 3, Floor) // NPC1 start
-    state.SetTileType(5, 5, Floor) // NPC2 start
+    // Link pairs of reachable floor tiles with teleporters
+    g.placeTeleporters(state, r)
     
     // Set flavor images for tiles
     g.setFlavorImages(state)
@@ -52,18 +165,63 @@ func (g *Generator) Generate(width, height int) *State {
     return state
 }
 
-// chooseGoalPosition selects a position for the goal
+// generateOpen builds the trivial last-resort maze: the whole interior is
+// floor, with the start and goal in opposite corners, so it's always winnable
+func (g *Generator) generateOpen(width, height int) *State {
+    state := NewStateWithBorder(width, height, g.BorderThickness)
+    g.rng = rand.New(rand.NewSource(g.RandomSeed))
+    g.Rooms = nil
+    
+    for y := 0; y < state.Height; y++ {
+        for x := 0; x < state.Width; x++ {
+            if state.InInterior(x, y) {
+                state.SetTileType(x, y, Floor)
+            }
+        }
+    }
+    
+    state.Start = Position{X: state.Border, Y: state.Border}
+    state.GoalX = state.Width - 1 - state.Border
+    state.GoalY = state.Height - 1 - state.Border
+    state.SetTileType(state.GoalX, state.GoalY, Goal)
+    
+    g.placeSpawns(state)
+    g.setFlavorImages(state)
+    
+    return state
+}
+
+// maxGoalAttempts stops the goal search on grids where no cell in the
+// goal quarter is far enough from the start
+const maxGoalAttempts = 100
+
+// chooseGoalPosition selects a position for the goal in the quarter of
+// GoalCorner, or across from the start when that quarter holds the start
 func (g *Generator) chooseGoalPosition(state *State, r *rand.Rand) (int, int) {
     width, height := state.Width, state.Height
     
-    // Choose a goal in the bottom-right quarter
+    startCorner := CornerOf(state.Start, width, height)
+    corner := g.GoalCorner
+    if corner == OppositeCorner || corner == startCorner {
+        corner = startCorner.Opposite()
+    }
+    
+    // Choose a goal within a quarter of the grid from the corner
+    // Grids under four tiles across still get a one-tile quarter
+    spanX, spanY := max(1, width/4), max(1, height/4)
     goalX, goalY := 0, 0
-    for {
-        goalX = width - 2 - r.Intn(width/4)
-        goalY = height - 2 - r.Intn(height/4)
+    for attempt := 0; attempt < maxGoalAttempts; attempt++ {
+        goalX = state.Border + r.Intn(spanX)
+        if corner.IsRight() {
+            goalX = width - 1 - goalX
+        }
+        goalY = state.Border + r.Intn(spanY)
+        if corner.IsBottom() {
+            goalY = height - 1 - goalY
+        }
         
         // Ensure the goal isn't too close to the start
-        if abs(goalX-1) + abs(goalY-1) >= (width + height)/3 {
+        if abs(goalX-state.Start.X) + abs(goalY-state.Start.Y) >= (width + height)/3 {
             break
         }
     }
@@ -72,7 +230,8 @@ func (g *Generator) chooseGoalPosition(state *State, r *rand.Rand) (int, int) {
 }
 
 // generatePathways creates the initial maze structure
-func (g *Generator) generatePathways(state *State, startX, startY int, r *rand.Rand) {
+// Cells are only carved in columns below maxX
+func (g *Generator) generatePathways(state *State, startX, startY, maxX int, r *rand.Rand) {
     // Initialize visited grid
     visited := make([][]bool, state.Height)
     for y := range visited {
@@ -101,7 +260,7 @@ func (g *Generator) generatePathways(state *State, startX, startY int, r *rand.R
             nx, ny := current.X + dx[d]*2, current.Y + dy[d]*2
             
             // Check if the neighbor is valid and unvisited
-            if nx >= 1 && nx < state.Width-1 && ny >= 1 && ny < state.Height-1 && !visited[ny][nx] {
+            if state.InInterior(nx, ny) && nx < maxX && !visited[ny][nx] {
                 neighbors = append(neighbors, d)
             }
         }
@@ -126,30 +285,35 @@ func (g *Generator) generatePathways(state *State, startX, startY int, r *rand.R
     }
 }
 
+// maxWallAttempts stops the search for a wall to open once the maze has
+// (almost) none left
+const maxWallAttempts = 100
+
 // addRandomPaths adds some random paths to make the maze more interesting
 func (g *Generator) addRandomPaths(state *State, r *rand.Rand) {
-    // Number of random paths to add (adjustable)
-    extraPaths := (state.Width + state.Height) / 3
+    // Number of random paths to add, scaled by the loop density setting
+    factor := math.Max(0, math.Min(g.ExtraPathFactor, MaxExtraPathFactor))
+    extraPaths := int(factor * float64(state.Width+state.Height) / 3)
     
     for i := 0; i < extraPaths; i++ {
-        // Pick a random wall that's not on the border
-        x, y := 0, 0
-        for {
-            x = r.Intn(state.Width-2) + 1
-            y = r.Intn(state.Height-2) + 1
-            
-            if state.GetTile(x, y).Type == Wall && 
-               x > 0 && x < state.Width-1 && y > 0 && y < state.Height-1 {
-                break
-            }
+        // Pick a random wall that's not on the border. Tiny mazes may
+        // have none left, so give up rather than search forever
+        x, y, found := 0, 0, false
+        for attempt := 0; attempt < maxWallAttempts && !found; attempt++ {
+            x = r.Intn(state.Width-2*state.Border) + state.Border
+            y = r.Intn(state.Height-2*state.Border) + state.Border
+            found = state.GetTile(x, y).Type == Wall && state.InInterior(x, y)
+        }
+        if !found {
+            return
         }
         
         // Count adjacent floor tiles
         floorCount := 0
-        if state.GetTile(y-1, x) != nil && state.GetTile(y-1, x).Type == Floor { floorCount++ }
-        if state.GetTile(y+1, x) != nil && state.GetTile(y+1, x).Type == Floor { floorCount++ }
-        if state.GetTile(y, x-1) != nil && state.GetTile(y, x-1).Type == Floor { floorCount++ }
-        if state.GetTile(y, x+1) != nil && state.GetTile(y, x+1).Type == Floor { floorCount++ }
+        if state.GetTile(x, y-1) != nil && state.GetTile(x, y-1).Type == Floor { floorCount++ }
+        if state.GetTile(x, y+1) != nil && state.GetTile(x, y+1).Type == Floor { floorCount++ }
+        if state.GetTile(x-1, y) != nil && state.GetTile(x-1, y).Type == Floor { floorCount++ }
+        if state.GetTile(x+1, y) != nil && state.GetTile(x+1, y).Type == Floor { floorCount++ }
         
         // Only remove walls that connect two different passages
         // This creates loops in the maze
@@ -159,6 +323,139 @@ func (g *Generator) addRandomPaths(state *State, r *rand.Rand) {
     }
 }
 
+// placeSpawns resolves each requested NPC spawn to the nearest floor tile
+// that isn't already taken by the start or another spawn, and is at least
+// MinSpawnSeparation steps from all of them
+func (g *Generator) placeSpawns(state *State) {
+    taken := map[Position]bool{state.Start: true}
+    placed := []Position{state.Start}
+    state.Spawns = make([]Position, 0, len(g.SpawnRequests))
+    
+    for _, request := range g.SpawnRequests {
+        spawn, ok := g.nearestSeparatedFloor(state, request, taken, placed)
+        if !ok {
+            continue // No free floor left for this NPC
+        }
+        taken[spawn] = true
+        placed = append(placed, spawn)
+        state.Spawns = append(state.Spawns, spawn)
+    }
+}
+
+// nearestSeparatedFloor finds the free floor tile nearest the request that
+// keeps MinSpawnSeparation from every placed position. If no tile is far
+// enough the separation is lowered a step at a time, down to none at all
+func (g *Generator) nearestSeparatedFloor(state *State, request Position, taken map[Position]bool, placed []Position) (Position, bool) {
+    for separation := g.MinSpawnSeparation; separation > 0; separation-- {
+        blocked := make(map[Position]bool, len(taken))
+        for pos := range taken {
+            blocked[pos] = true
+        }
+        for y := 0; y < state.Height; y++ {
+            for x := 0; x < state.Width; x++ {
+                cell := Position{X: x, Y: y}
+                for _, other := range placed {
+                    if cell.ManhattanDistance(other) < separation {
+                        blocked[cell] = true
+                        break
+                    }
+                }
+            }
+        }
+        
+        if spawn, ok := state.NearestFloor(request, blocked); ok {
+            return spawn, true
+        }
+    }
+    
+    return state.NearestFloor(request, taken)
+}
+
+// placeTraps turns random floor tiles into traps, keeping the start and
+// spawn cells clear. Traps are walkable so connectivity is unaffected
+func (g *Generator) placeTraps(state *State, r *rand.Rand) {
+    reserved := map[Position]bool{state.Start: true}
+    for _, spawn := range state.Spawns {
+        reserved[spawn] = true
+    }
+    
+    // Collect candidate floor tiles
+    candidates := []Position{}
+    for _, pos := range state.TilesOfType(Floor) {
+        if !reserved[pos] {
+            candidates = append(candidates, pos)
+        }
+    }
+    
+    r.Shuffle(len(candidates), func(i, j int) {
+        candidates[i], candidates[j] = candidates[j], candidates[i]
+    })
+    
+    // Symmetric mazes trap the reflected tile too, skipping tiles whose
+    // reflection isn't free floor
+    placed := 0
+    for _, pos := range candidates {
+        if placed == g.TrapCount {
+            break
+        }
+        mirror := g.Symmetry.Reflect(pos, state.Width, state.Height)
+        if state.Grid[pos.Y][pos.X].Type != Floor || reserved[mirror] || state.Grid[mirror.Y][mirror.X].Type != Floor {
+            continue
+        }
+        state.SetTileType(pos.X, pos.Y, Trap)
+        state.SetTileType(mirror.X, mirror.Y, Trap)
+        placed++
+    }
+}
+
+// placeTeleporters turns pairs of floor tiles reachable from the start into
+// linked teleporters, keeping the start and spawn cells clear
+func (g *Generator) placeTeleporters(state *State, r *rand.Rand) {
+    reserved := map[Position]bool{state.Start: true}
+    for _, spawn := range state.Spawns {
+        reserved[spawn] = true
+    }
+    
+    // Collect candidate floor tiles
+    reachable := reachableCells(state, state.Start)
+    candidates := []Position{}
+    for _, pos := range state.TilesOfType(Floor) {
+        if reachable[pos.Y][pos.X] && !reserved[pos] {
+            candidates = append(candidates, pos)
+        }
+    }
+    
+    r.Shuffle(len(candidates), func(i, j int) {
+        candidates[i], candidates[j] = candidates[j], candidates[i]
+    })
+    free := func(p Position) bool {
+        return reachable[p.Y][p.X] && !reserved[p] && state.Grid[p.Y][p.X].Type == Floor
+    }
+    
+    // Symmetric mazes link the reflected tiles too, unless the pair is
+    // already its own reflection
+    placed := 0
+    for i := 0; placed < g.TeleporterPairs && i+1 < len(candidates); i += 2 {
+        a, b := candidates[i], candidates[i+1]
+        mirrorA := g.Symmetry.Reflect(a, state.Width, state.Height)
+        mirrorB := g.Symmetry.Reflect(b, state.Width, state.Height)
+        if !free(a) || !free(b) {
+            continue
+        }
+        
+        switch {
+        case mirrorA == a && mirrorB == b, mirrorA == b && mirrorB == a:
+            state.AddTeleporterPair(a, b)
+        case mirrorA != a && mirrorA != b && mirrorB != a && mirrorB != b && free(mirrorA) && free(mirrorB):
+            state.AddTeleporterPair(a, b)
+            state.AddTeleporterPair(mirrorA, mirrorB)
+        default:
+            continue
+        }
+        placed++
+    }
+}
+
 // ensurePathToGoal makes sure there's a path from start to goal
 func (g *Generator) ensurePathToGoal(state *State, startX, startY, goalX, goalY int) {
     // Use breadth-first search to check if there's a path
@@ -172,7 +469,7 @@ func (g *Generator) ensurePathToGoal(state *State, startX, startY, goalX, goalY
     // Move toward the goal with a slight randomness
     for currentX != goalX || currentY != goalY {
         // Decide whether to move in X or Y direction
-        moveX := rand.Intn(2) == 0
+        moveX := g.intn(2) == 0
         
         if moveX && currentX != goalX {
             // Move in X direction
@@ -180,7 +477,6 @@ func (g *Generator) ensurePathToGoal(state *State, startX, startY, goalX, goalY
             if currentX > goalX {
                 dx = -1
             }
-            state.SetTileType(currentX + dx, currentY, Floor)
             currentX += dx
         } else if currentY != goalY {
             // Move in Y direction
@@ -188,13 +484,42 @@ func (g *Generator) ensurePathToGoal(state *State, startX, startY, goalX, goalY
             if currentY > goalY {
                 dy = -1
             }
-            state.SetTileType(currentX, currentY + dy, Floor)
             currentY += dy
         }
         
-        // Set current position to floor
-        state.SetTileType(currentX, currentY, Floor)
+        // Carve walls on the way and their reflections, leaving the goal
+        // and special tiles in place
+        g.carveFloor(state, Position{X: currentX, Y: currentY})
+    }
+}
+
+// intn returns a random number from the generation's seeded source,
+// falling back to the global source outside of Generate
+func (g *Generator) intn(n int) int {
+    if g.rng == nil {
+        return rand.Intn(n)
+    }
+    return g.rng.Intn(n)
+}
+
+// ValidateReachability runs a BFS from every spawn to the goal and carves
+// a connector for any spawn that is walled off.
+// Returns true if every spawn could already reach the goal
+func (g *Generator) ValidateReachability(state *State, spawns []Position) bool {
+    allReachable := true
+    
+    for _, spawn := range spawns {
+        if state.GetTile(spawn.X, spawn.Y) == nil {
+            continue // Spawn is outside the grid
+        }
+        
+        if !g.hasPath(state, spawn.X, spawn.Y, state.GoalX, state.GoalY) {
+            allReachable = false
+            g.ensurePathToGoal(state, spawn.X, spawn.Y, state.GoalX, state.GoalY)
+        }
     }
+    
+    return allReachable
 }
 
 // hasPath checks if there's a path from start to goal
@@ -0,0 +1,229 @@
+// internal/game/maze/generator_test.go
+package maze
+
+import (
+    "math/rand"
+    "testing"
+
+    "github.com/JacobCromwell/Mazenasium/internal/game/logging"
+)
+
+// newTestGenerator creates a generator that doesn't report its timings
+func newTestGenerator(seed int64) *Generator {
+    g := NewGenerator(seed)
+    g.Logger = logging.Nop{}
+    return g
+}
+
+func TestGeneratedSpawnsReachGoal(t *testing.T) {
+    for seed := int64(1); seed <= 50; seed++ {
+        g := newTestGenerator(seed)
+        g.SpawnRequests = []Position{{X: 3, Y: 3}, {X: 5, Y: 5}, {X: 15, Y: 3}, {X: 3, Y: 15}}
+        state := g.Generate(21, 21)
+
+        if len(state.Spawns) != len(g.SpawnRequests) {
+            t.Fatalf("seed %d: %d spawns placed, want %d", seed, len(state.Spawns), len(g.SpawnRequests))
+        }
+        for _, spawn := range append([]Position{state.Start}, state.Spawns...) {
+            if !g.hasPath(state, spawn.X, spawn.Y, state.GoalX, state.GoalY) {
+                t.Errorf("seed %d: spawn %v can't reach the goal at (%d,%d)", seed, spawn, state.GoalX, state.GoalY)
+            }
+        }
+    }
+}
+
+func TestValidateReachabilityConnectsWalledOffSpawn(t *testing.T) {
+    g := newTestGenerator(1)
+    state := NewState(9, 9)
+    for x := 1; x < 8; x++ {
+        state.SetTileType(x, 1, Floor)
+    }
+    state.SetTileType(7, 1, Goal)
+    state.GoalX, state.GoalY = 7, 1
+
+    // A floor pocket with no way out
+    state.SetTileType(3, 6, Floor)
+    spawn := Position{X: 3, Y: 6}
+
+    if g.ValidateReachability(state, []Position{{X: 1, Y: 1}, spawn}) {
+        t.Fatal("the walled-off spawn should have needed a connector")
+    }
+    if !g.hasPath(state, spawn.X, spawn.Y, 7, 1) {
+        t.Error("spawn still can't reach the goal after validation")
+    }
+    if !g.ValidateReachability(state, []Position{{X: 1, Y: 1}, spawn}) {
+        t.Error("a second validation should find every spawn connected")
+    }
+}
+
+func TestPlacedEntitiesStartOnFloor(t *testing.T) {
+    sizes := [][2]int{{7, 7}, {10, 10}, {20, 20}, {40, 40}, {15, 31}}
+    for _, size := range sizes {
+        for seed := int64(1); seed <= 10; seed++ {
+            g := newTestGenerator(seed)
+            // Requests on walls, the border and outside the grid
+            g.SpawnRequests = []Position{{X: 0, Y: 0}, {X: 3, Y: 3}, {X: 100, Y: 100}, {X: -5, Y: 2}}
+            state := g.Generate(size[0], size[1])
+
+            seen := map[Position]bool{}
+            for _, pos := range append([]Position{state.Start}, state.Spawns...) {
+                if tile := state.GetTile(pos.X, pos.Y); tile == nil || !tile.IsFloor() {
+                    t.Errorf("%dx%d seed %d: entity placed on %v, which isn't floor", size[0], size[1], seed, pos)
+                }
+                if seen[pos] {
+                    t.Errorf("%dx%d seed %d: two entities share %v", size[0], size[1], seed, pos)
+                }
+                seen[pos] = true
+            }
+        }
+    }
+}
+
+// loopCount returns how many more passages the floor has than a tree
+// spanning it would, which is the number of independent loops
+func loopCount(state *State) int {
+    floors, edges := 0, 0
+    for y := 0; y < state.Height; y++ {
+        for x := 0; x < state.Width; x++ {
+            if state.Grid[y][x].IsWall() {
+                continue
+            }
+            floors++
+            if right := state.GetTile(x+1, y); right != nil && !right.IsWall() {
+                edges++
+            }
+            if below := state.GetTile(x, y+1); below != nil && !below.IsWall() {
+                edges++
+            }
+        }
+    }
+    return edges - (floors - 1)
+}
+
+// carveWithLoops carves a perfect maze and opens extra paths by factor
+func carveWithLoops(seed int64, factor float64) *State {
+    g := newTestGenerator(seed)
+    g.ExtraPathFactor = factor
+    state := NewStateWithBorder(31, 31, g.BorderThickness)
+    r := rand.New(rand.NewSource(seed))
+    g.generatePathways(state, 1, 1, state.Width-1, r)
+    g.addRandomPaths(state, r)
+    return state
+}
+
+func TestZeroLoopFactorKeepsPerfectMaze(t *testing.T) {
+    for seed := int64(1); seed <= 10; seed++ {
+        if loops := loopCount(carveWithLoops(seed, 0)); loops != 0 {
+            t.Errorf("seed %d: %d loops with factor 0, want a single-solution maze", seed, loops)
+        }
+    }
+}
+
+func TestHigherLoopFactorsOpenMorePaths(t *testing.T) {
+    for seed := int64(1); seed <= 10; seed++ {
+        previous := 0
+        for _, factor := range []float64{0.5, 1, 2, MaxExtraPathFactor} {
+            loops := loopCount(carveWithLoops(seed, factor))
+            if loops <= previous {
+                t.Errorf("seed %d: %d loops at factor %v, want more than %d", seed, loops, factor, previous)
+            }
+            previous = loops
+        }
+    }
+}
+
+func TestCarvingNeverTouchesBorder(t *testing.T) {
+    for _, thickness := range []int{1, 2} {
+        for seed := int64(1); seed <= 20; seed++ {
+            g := newTestGenerator(seed)
+            g.BorderThickness = thickness
+            g.RoomCount = 2
+            g.ExtraPathFactor = MaxExtraPathFactor
+            state := g.Generate(25, 21)
+            
+            if state.Border != thickness {
+                t.Fatalf("thickness %d: state border = %d", thickness, state.Border)
+            }
+            for y := 0; y < state.Height; y++ {
+                for x := 0; x < state.Width; x++ {
+                    if !state.InInterior(x, y) && !state.Grid[y][x].IsWall() {
+                        t.Errorf("thickness %d seed %d: border cell (%d,%d) was carved", thickness, seed, x, y)
+                    }
+                }
+            }
+        }
+    }
+}
+
+func TestSpawnsKeepTheirSeparation(t *testing.T) {
+    for seed := int64(1); seed <= 20; seed++ {
+        g := newTestGenerator(seed)
+        g.MinSpawnSeparation = 6
+        g.SpawnRequests = []Position{{X: 2, Y: 2}, {X: 3, Y: 1}, {X: 18, Y: 18}}
+        state := g.Generate(21, 21)
+        
+        placed := append([]Position{state.Start}, state.Spawns...)
+        if len(placed) != 4 {
+            t.Fatalf("seed %d: %d spawns placed, want 3", seed, len(state.Spawns))
+        }
+        for i := range placed {
+            for j := i + 1; j < len(placed); j++ {
+                if d := placed[i].ManhattanDistance(placed[j]); d < g.MinSpawnSeparation {
+                    t.Errorf("seed %d: %v and %v are %d steps apart, want at least %d", seed, placed[i], placed[j], d, g.MinSpawnSeparation)
+                }
+            }
+        }
+    }
+}
+
+func TestSeparationRelaxesOnTinyMazes(t *testing.T) {
+    state := parseGrid(
+        "#####",
+        "#...#",
+        "#####",
+    )
+    state.Start = Position{X: 1, Y: 1}
+    g := newTestGenerator(1)
+    g.MinSpawnSeparation = 100 // Far more than the maze can give
+    g.SpawnRequests = []Position{{X: 1, Y: 1}, {X: 3, Y: 1}, {X: 2, Y: 1}}
+    
+    g.placeSpawns(state)
+    
+    if len(state.Spawns) != 2 {
+        t.Fatalf("%d spawns placed on a three-tile maze, want the two free tiles: %v", len(state.Spawns), state.Spawns)
+    }
+    if state.Spawns[0] == state.Spawns[1] || state.Spawns[0] == state.Start || state.Spawns[1] == state.Start {
+        t.Errorf("spawns %v share a cell with each other or the start", state.Spawns)
+    }
+}
+
+func TestTinyMazesAreStillSolvable(t *testing.T) {
+    sizes := [][2]int{{3, 3}, {4, 4}, {5, 5}, {3, 9}, {9, 3}, {6, 5}}
+    for _, size := range sizes {
+        for seed := int64(1); seed <= 30; seed++ {
+            g := newTestGenerator(seed)
+            g.RoomCount = 2
+            g.ExtraPathFactor = MaxExtraPathFactor
+            state := g.Generate(size[0], size[1])
+            
+            if !state.GetTile(state.GoalX, state.GoalY).IsGoal() {
+                t.Fatalf("%dx%d seed %d: no goal tile at (%d,%d)", size[0], size[1], seed, state.GoalX, state.GoalY)
+            }
+            if !g.hasPath(state, state.Start.X, state.Start.Y, state.GoalX, state.GoalY) {
+                t.Errorf("%dx%d seed %d: the start %v can't reach the goal", size[0], size[1], seed, state.Start)
+            }
+        }
+    }
+}
+
+func TestOpenFallbackIsSolvable(t *testing.T) {
+    g := newTestGenerator(1)
+    state := g.generateOpen(7, 5)
+    
+    if state.Start == (Position{X: state.GoalX, Y: state.GoalY}) {
+        t.Fatal("the fallback put the start on the goal")
+    }
+    if !g.hasPath(state, state.Start.X, state.Start.Y, state.GoalX, state.GoalY) {
+        t.Error("the fallback maze can't be solved")
+    }
+}
@@ -8,6 +8,8 @@ import (
 type Maze struct {
     State     *State
     Generator *Generator
+    Rand      *rand.Rand // Seeded source for in-game randomness such as row shuffles
+    TileSize  float64    // Size of each tile in pixels
 }
 
 // New creates a new maze with the specified dimensions
@@ -28,13 +30,63 @@ type Maze struct {
 //     }
 // }
 
+// Config holds the options used to generate a maze
+type Config struct {
+    Width, Height int      // Grid dimensions in tiles
+    Symmetry      Symmetry // Optional mirror or rotational symmetry
+    Seed          int64    // Seed for reproducible mazes, 0 picks a random one
+    
+    // Loop density, 0 is a perfect maze and DefaultExtraPathFactor the usual mix
+    ExtraPathFactor float64
+    
+    // Wall frame thickness in tiles, 0 uses DefaultBorderThickness
+    BorderThickness int
+    
+    // Corners the player starts in and the goal is placed in.
+    // OppositeCorner keeps the usual start and puts the goal across from it
+    StartCorner, GoalCorner Corner
+    
+    // Double Width and Height before generating, as New always has
+    LegacyDoubling bool
+    
+    // Fewest steps between NPC spawns and the player's start, 0 for no limit
+    MinSpawnSeparation int
+    
+    // Rectangular rooms to open up and their largest side in tiles,
+    // 0 rooms for corridors only and 0 size for DefaultRoomMaxSize
+    RoomCount, RoomSize int
+}
+
+// New creates a new maze twice the given dimensions, kept for older callers
 func New(width, height int, centerX, centerY int) *Maze {
-    // Double the dimensions for a larger maze
-    width = width * 2
-    height = height * 2
+    return NewWithConfig(Config{Width: width, Height: height, ExtraPathFactor: DefaultExtraPathFactor, LegacyDoubling: true})
+}
+
+// NewWithConfig creates a new maze using the given generation options
+func NewWithConfig(cfg Config) *Maze {
+    width, height := cfg.Width, cfg.Height
+    if cfg.LegacyDoubling {
+        width, height = width*2, height*2
+    }
     
-    // Create a generator with a random seed
-    generator := NewGenerator(rand.Int63())
+    // Create a generator, with a random seed unless one was given
+    seed := cfg.Seed
+    if seed == 0 {
+        seed = rand.Int63()
+    }
+    generator := NewGenerator(seed)
+    generator.Symmetry = cfg.Symmetry
+    generator.ExtraPathFactor = cfg.ExtraPathFactor
+    if cfg.BorderThickness > 0 {
+        generator.BorderThickness = cfg.BorderThickness
+    }
+    generator.StartCorner = cfg.StartCorner
+    generator.GoalCorner = cfg.GoalCorner
+    generator.MinSpawnSeparation = cfg.MinSpawnSeparation
+    generator.RoomCount = cfg.RoomCount
+    if cfg.RoomSize > 0 {
+        generator.RoomMaxSize = cfg.RoomSize
+    }
     
     // Generate the initial maze state
     state := generator.Generate(width, height)
@@ -42,9 +94,21 @@ func New(width, height int, centerX, centerY int) *Maze {
     return &Maze{
         State:     state,
         Generator: generator,
+        Rand:      rand.New(rand.NewSource(generator.RandomSeed)),
+        TileSize:  TileSize,
     }
 }
 
+// StartPosition returns the cell the player starts on
+func (m *Maze) StartPosition() Position {
+    return m.State.Start
+}
+
+// SpawnPositions returns the floor cells NPCs start on
+func (m *Maze) SpawnPositions() []Position {
+    return m.State.Spawns
+}
+
 // IsWall checks if the given coordinates are a wall
 func (m *Maze) IsWall(x, y int) bool {
     tile := m.State.GetTile(x, y)
@@ -81,14 +145,119 @@ func (m *Maze) ClearHighlights() {
     m.State.ClearHighlights()
 }
 
+// SimulateXRotate returns the row as it would look after an X-rotation
+// without changing the maze
+func (m *Maze) SimulateXRotate(playerX, playerY, direction int) []*Tile {
+    return m.State.SimulateXRotate(playerX, playerY, direction)
+}
+
 // PerformXRotate performs the rotation of tiles on the X-axis
 func (m *Maze) PerformXRotate(playerX, playerY, direction int) {
     m.State.PerformXRotate(playerX, playerY, direction)
 }
 
+// HasPath checks if there's a walkable path between two cells on the live grid
+func (m *Maze) HasPath(fromX, fromY, toX, toY int) bool {
+    if m.State.GetTile(fromX, fromY) == nil || m.State.GetTile(toX, toY) == nil {
+        return false
+    }
+    return m.Generator.hasPath(m.State, fromX, fromY, toX, toY)
+}
+
+// CanReachGoal checks if the goal can be reached from the given cell
+func (m *Maze) CanReachGoal(from Position) bool {
+    goal, ok := m.State.FindGoal()
+    return ok && m.HasPath(from.X, from.Y, goal.X, goal.Y)
+}
+
+// IsSolvable checks if the goal can still be reached from the carved start
+// on the live grid
+func (m *Maze) IsSolvable() bool {
+    return m.CanReachGoal(m.State.Start)
+}
+
+// EnsureSolvable carves a connector to the goal from the start and from each
+// of the given cells that can no longer reach it.
+// Returns true if the maze had to be repaired
+func (m *Maze) EnsureSolvable(from ...Position) bool {
+    goal, ok := m.State.FindGoal()
+    if !ok {
+        return false
+    }
+    m.State.GoalX, m.State.GoalY = goal.X, goal.Y
+    
+    repaired := false
+    for _, pos := range append([]Position{m.State.Start}, from...) {
+        if m.State.GetTile(pos.X, pos.Y) == nil || m.HasPath(pos.X, pos.Y, goal.X, goal.Y) {
+            continue
+        }
+        m.Generator.ensurePathToGoal(m.State, pos.X, pos.Y, goal.X, goal.Y)
+        repaired = true
+    }
+    return repaired
+}
+
+// HighlightRow highlights the interior tiles of the player's row
+func (m *Maze) HighlightRow(playerX, playerY int) {
+    m.State.HighlightXRotation(playerX, playerY)
+}
+
+// ShuffleRow randomly permutes the interior tiles of the player's row.
+// The shuffle is rejected, leaving the grid untouched, if it would put a
+// wall on an entity or cut the player or any entity off from the goal.
+// Returns true if the shuffle was applied
+func (m *Maze) ShuffleRow(playerX, playerY int, entityPositions []Position) bool {
+    if playerY < 0 || playerY >= m.State.Height {
+        return false
+    }
+    
+    shuffled := m.State.SimulateShuffleRow(playerX, playerY, m.Rand)
+    
+    // Never drop a wall onto a player or NPC
+    for _, pos := range entityPositions {
+        if pos.Y == playerY && pos.X >= 0 && pos.X < len(shuffled) && shuffled[pos.X].IsWall() {
+            return false
+        }
+    }
+    
+    // Apply the shuffle, then revert if anyone can no longer reach the goal
+    original := m.State.Row(playerY)
+    m.State.SetRow(playerY, shuffled)
+    
+    for _, pos := range append([]Position{{X: playerX, Y: playerY}}, entityPositions...) {
+        if !m.CanReachGoal(pos) {
+            m.State.SetRow(playerY, original)
+            return false
+        }
+    }
+    
+    goal, _ := m.State.FindGoal()
+    m.State.GoalX, m.State.GoalY = goal.X, goal.Y
+    m.State.ClearHighlights()
+    return true
+}
+
 // GetTileSize returns the size of each tile in pixels
 func (m *Maze) GetTileSize() float64 {
-    return TileSize
+    if m.TileSize <= 0 {
+        return TileSize
+    }
+    return m.TileSize
+}
+
+// SetTileSize changes the size of each tile in pixels.
+// Non-positive sizes fall back to the default TileSize
+func (m *Maze) SetTileSize(size float64) {
+    if size <= 0 {
+        size = TileSize
+    }
+    m.TileSize = size
+}
+
+// PixelSize returns the width and height of the whole maze in pixels
+func (m *Maze) PixelSize() (float64, float64) {
+    size := m.GetTileSize()
+    return float64(m.State.Width) * size, float64(m.State.Height) * size
 }
 
 // internal/game/maze/maze.go
@@ -102,39 +271,20 @@ func (m *Maze) CheckXRotateCollisions(playerX, playerY, direction int, entityPos
         return false
     }
     
-    // Create a map of entity positions for quick lookup
-    entityMap := make(map[Position]bool)
-    for _, pos := range entityPositions {
-        entityMap[pos] = true
-    }
+    // Simulate the rotation and check for collisions
+    rotated := m.State.SimulateXRotate(playerX, playerY, direction)
     
-    // Get a copy of the current row for rotation simulation
-    row := m.State.Grid[playerY]
+    // Only the cells the rotation moves can have a wall dropped on them,
+    // the same cells HighlightXRotation marks
+    affected := map[Position]bool{}
+    for _, cell := range m.State.RotationAffectedCells(playerX, playerY) {
+        affected[cell] = true
+    }
     
-    // Simulate the rotation and check for collisions
-    for x := 1; x < m.State.Width-1; x++ {
-        // Skip player position
-        if x == playerX {
-            continue
-        }
-        
-        // Calculate new position after rotation
-        newX := x + direction
-        
-        // Handle wrapping within the playable area (excluding boundary walls)
-        if newX <= 0 {
-            newX = m.State.Width - 2
-        } else if newX >= m.State.Width-1 {
-            newX = 1
-        }
-        
-        // Check if we're moving a wall onto an entity position
-        if row[x].Type == Wall {
-            // Check if there's an entity at the destination
-            pos := Position{X: newX, Y: playerY}
-            if entityMap[pos] {
-                return true // Collision detected!
-            }
+    // Check if we're moving a wall onto an entity position
+    for _, pos := range entityPositions {
+        if affected[pos] && rotated[pos.X].IsWall() {
+            return true // Collision detected!
         }
     }
     
@@ -0,0 +1,213 @@
+// internal/game/maze/maze_test.go
+package maze
+
+import (
+    "math/rand"
+    "testing"
+)
+
+// rowTypes returns the tile types along a row
+func rowTypes(state *State, y int) []TileType {
+    types := make([]TileType, state.Width)
+    for x := range types {
+        types[x] = state.Grid[y][x].Type
+    }
+    return types
+}
+
+// countTypes tallies each tile type along a row
+func countTypes(types []TileType) map[TileType]int {
+    counts := map[TileType]int{}
+    for _, t := range types {
+        counts[t]++
+    }
+    return counts
+}
+
+func TestShuffleRowPreservesTileTypes(t *testing.T) {
+    for seed := int64(1); seed <= 20; seed++ {
+        m := newTestMaze(
+            "#########",
+            "#.#.T.#.#",
+            "#.......#",
+            "#......G#",
+            "#########",
+        )
+        m.Rand = rand.New(rand.NewSource(seed))
+        before := rowTypes(m.State, 1)
+
+        if !m.ShuffleRow(1, 1, nil) {
+            continue // Rejected shuffles are covered separately
+        }
+
+        after := rowTypes(m.State, 1)
+        beforeCounts, afterCounts := countTypes(before), countTypes(after)
+        for tileType, count := range beforeCounts {
+            if afterCounts[tileType] != count {
+                t.Errorf("seed %d: %d %v tiles after the shuffle, want %d", seed, afterCounts[tileType], tileType, count)
+            }
+        }
+        if after[0] != Wall || after[len(after)-1] != Wall || after[1] != before[1] {
+            t.Errorf("seed %d: the border or the player's own tile moved: %v", seed, after)
+        }
+    }
+}
+
+func TestShuffleRowRejectsBlockingTheGoal(t *testing.T) {
+    rejected := 0
+    for seed := int64(1); seed <= 30; seed++ {
+        // The NPC on (5,3) can only reach the goal along row 1,
+        // which stays open only while its wall keeps to (6,1)
+        m := newTestMaze(
+            "########",
+            "#.....##",
+            "#.###.##",
+            "#G###.##",
+            "########",
+        )
+        m.Rand = rand.New(rand.NewSource(seed))
+        npc := Position{X: 5, Y: 3}
+        before := rowTypes(m.State, 1)
+
+        if m.ShuffleRow(1, 1, []Position{{X: 1, Y: 1}, npc}) {
+            if !m.CanReachGoal(npc) {
+                t.Errorf("seed %d: shuffle applied although it cut the NPC off from the goal", seed)
+            }
+            continue
+        }
+
+        rejected++
+        after := rowTypes(m.State, 1)
+        for x := range before {
+            if after[x] != before[x] {
+                t.Fatalf("seed %d: rejected shuffle changed the row from %v to %v", seed, before, after)
+            }
+        }
+    }
+
+    if rejected == 0 {
+        t.Error("no shuffle was rejected, the blocking case wasn't exercised")
+    }
+}
+
+func TestShuffleRowNeverDropsWallOnEntity(t *testing.T) {
+    for seed := int64(1); seed <= 30; seed++ {
+        m := newTestMaze(
+            "#######",
+            "#..#..#",
+            "#.....#",
+            "#....G#",
+            "#######",
+        )
+        m.Rand = rand.New(rand.NewSource(seed))
+        npc := Position{X: 4, Y: 1}
+
+        if m.ShuffleRow(1, 1, []Position{npc}) && m.IsWall(npc.X, npc.Y) {
+            t.Errorf("seed %d: shuffle dropped a wall on the NPC", seed)
+        }
+    }
+}
+
+func TestPixelSizeFollowsTileSize(t *testing.T) {
+    m := newTestMaze(
+        "#####",
+        "#..G#",
+        "#####",
+    )
+
+    m.SetTileSize(40)
+    if w, h := m.PixelSize(); w != 200 || h != 120 {
+        t.Errorf("PixelSize() = %vx%v at 40px, want 200x120", w, h)
+    }
+
+    m.SetTileSize(0)
+    if got := m.GetTileSize(); got != TileSize {
+        t.Errorf("GetTileSize() = %v after a zero size, want the default %v", got, float64(TileSize))
+    }
+}
+
+func TestIsSolvableOnOpenAndBlockedGrids(t *testing.T) {
+    open := newTestMaze(
+        "#######",
+        "#.....#",
+        "#.###.#",
+        "#...#G#",
+        "#######",
+    )
+    open.State.Start = Position{X: 1, Y: 1}
+    if !open.IsSolvable() {
+        t.Error("the goal can be reached along the top corridor")
+    }
+    
+    blocked := newTestMaze(
+        "#######",
+        "#...#.#",
+        "#.###.#",
+        "#...#G#",
+        "#######",
+    )
+    blocked.State.Start = Position{X: 1, Y: 1}
+    if blocked.IsSolvable() {
+        t.Error("a wall cuts the start off from the goal")
+    }
+    if !blocked.CanReachGoal(Position{X: 5, Y: 1}) {
+        t.Error("a cell on the goal's side should still reach it")
+    }
+}
+
+func TestIsSolvableWithoutGoal(t *testing.T) {
+    m := newTestMaze(
+        "#####",
+        "#...#",
+        "#####",
+    )
+    m.State.Start = Position{X: 1, Y: 1}
+    if m.IsSolvable() {
+        t.Error("a maze with no goal can't be solved")
+    }
+}
+
+func TestNewWithConfigKeepsRequestedSize(t *testing.T) {
+    m := NewWithConfig(Config{Width: 20, Height: 20, Seed: 1})
+    if m.State.Width != 20 || m.State.Height != 20 || len(m.State.Grid) != 20 || len(m.State.Grid[0]) != 20 {
+        t.Errorf("NewWithConfig built a %dx%d grid, want 20x20", m.State.Width, m.State.Height)
+    }
+    
+    doubled := NewWithConfig(Config{Width: 20, Height: 20, Seed: 1, LegacyDoubling: true})
+    if doubled.State.Width != 40 || doubled.State.Height != 40 {
+        t.Errorf("legacy doubling built a %dx%d grid, want 40x40", doubled.State.Width, doubled.State.Height)
+    }
+}
+
+func TestHighlightAndCollisionsShareCells(t *testing.T) {
+    rows := []string{
+        "#########",
+        "#.#.#.#.#",
+        "#......G#",
+        "#########",
+    }
+    // Players on either edge of the interior, in the middle and on the border
+    for _, playerX := range []int{1, 4, 7, 0, 8} {
+        m := newTestMaze(rows...)
+        m.State.HighlightXRotation(playerX, 1)
+        
+        for _, direction := range []int{1, -1} {
+            rotated := m.State.SimulateXRotate(playerX, 1, direction)
+            for x := 0; x < m.State.Width; x++ {
+                highlighted := m.State.Grid[1][x].Highlighted
+                collides := m.CheckXRotateCollisions(playerX, 1, direction, []Position{{X: x, Y: 1}})
+                
+                if collides && !highlighted {
+                    t.Errorf("player x=%d direction %d: collision on (%d,1), which isn't highlighted", playerX, direction, x)
+                }
+                if highlighted && rotated[x].IsWall() && !collides {
+                    t.Errorf("player x=%d direction %d: wall lands on highlighted (%d,1) without a collision", playerX, direction, x)
+                }
+            }
+        }
+        
+        if m.State.Grid[1][0].Highlighted || m.State.Grid[1][8].Highlighted {
+            t.Errorf("player x=%d: the border was highlighted", playerX)
+        }
+    }
+}
@@ -0,0 +1,122 @@
+// internal/game/maze/path.go
+package maze
+
+// FindGoal scans the live grid for the goal tile.
+// GoalX/GoalY can go stale once rotations move the goal tile around
+func (s *State) FindGoal() (Position, bool) {
+    for y := 0; y < s.Height; y++ {
+        for x := 0; x < s.Width; x++ {
+            if s.Grid[y][x] != nil && s.Grid[y][x].IsGoal() {
+                return Position{X: x, Y: y}, true
+            }
+        }
+    }
+    return Position{}, false
+}
+
+// SetGoalHighlight turns the highlight on the goal tile on or off
+// Returns false if the grid has no goal
+func (s *State) SetGoalHighlight(on bool) bool {
+    goal, ok := s.FindGoal()
+    if !ok {
+        return false
+    }
+    s.Grid[goal.Y][goal.X].Highlighted = on
+    s.dirty = true
+    return true
+}
+
+// ShortestPath returns the cells from start to target (both inclusive)
+// using a breadth-first search, or nil if the target can't be reached
+func (s *State) ShortestPath(from, to Position) []Position {
+    if !s.IsValidMove(from.X, from.Y) || !s.IsValidMove(to.X, to.Y) {
+        return nil
+    }
+    
+    // Track where each visited cell was reached from
+    parents := make(map[Position]Position)
+    parents[from] = from
+    queue := []Position{from}
+    
+    // Directions: North, East, South, West
+    dx := []int{0, 1, 0, -1}
+    dy := []int{-1, 0, 1, 0}
+    
+    for len(queue) > 0 {
+        current := queue[0]
+        queue = queue[1:]
+        
+        if current == to {
+            // Walk the parents back to the start
+            path := []Position{current}
+            for current != from {
+                current = parents[current]
+                path = append([]Position{current}, path...)
+            }
+            return path
+        }
+        
+        for d := 0; d < 4; d++ {
+            next := Position{X: current.X + dx[d], Y: current.Y + dy[d]}
+            if _, seen := parents[next]; seen || !s.IsValidMove(next.X, next.Y) {
+                continue
+            }
+            parents[next] = current
+            queue = append(queue, next)
+        }
+    }
+    
+    return nil
+}
+
+// NextStepToGoal returns the first cell on the shortest path from the
+// given position to the goal. Returns false if the goal can't be reached
+func (m *Maze) NextStepToGoal(x, y int) (Position, bool) {
+    goal, ok := m.State.FindGoal()
+    if !ok {
+        return Position{}, false
+    }
+    
+    path := m.State.ShortestPath(Position{X: x, Y: y}, goal)
+    if len(path) < 2 {
+        return Position{}, false // Unreachable, or already on the goal
+    }
+    
+    return path[1], true
+}
+
+// NearestUnexplored returns the closest walkable cell the player hasn't
+// visited yet, by walking distance from the given position.
+// Returns false if every reachable cell has been explored
+func (s *State) NearestUnexplored(from Position) (Position, bool) {
+    if !s.IsValidMove(from.X, from.Y) {
+        return Position{}, false
+    }
+    
+    seen := map[Position]bool{from: true}
+    queue := []Position{from}
+    
+    // Directions: North, East, South, West
+    dx := []int{0, 1, 0, -1}
+    dy := []int{-1, 0, 1, 0}
+    
+    for len(queue) > 0 {
+        current := queue[0]
+        queue = queue[1:]
+        
+        if !s.Grid[current.Y][current.X].Visited {
+            return current, true
+        }
+        
+        for d := 0; d < 4; d++ {
+            next := Position{X: current.X + dx[d], Y: current.Y + dy[d]}
+            if seen[next] || !s.IsValidMove(next.X, next.Y) {
+                continue
+            }
+            seen[next] = true
+            queue = append(queue, next)
+        }
+    }
+    
+    return Position{}, false
+}
@@ -0,0 +1,55 @@
+// internal/game/maze/path_test.go
+package maze
+
+import "testing"
+
+// exploreAll marks every walkable tile as visited
+func exploreAll(state *State) {
+    for y := 0; y < state.Height; y++ {
+        for x := 0; x < state.Width; x++ {
+            if state.IsValidMove(x, y) {
+                state.MarkExplored(x, y)
+            }
+        }
+    }
+}
+
+func TestNearestUnexploredFindsTheLastTile(t *testing.T) {
+    state := parseGrid(
+        "#######",
+        "#...#.#",
+        "#.#...#",
+        "#....G#",
+        "#######",
+    )
+    exploreAll(state)
+    state.Grid[1][5].Visited = false
+    
+    got, ok := state.NearestUnexplored(Position{X: 1, Y: 1})
+    if !ok || got != (Position{X: 5, Y: 1}) {
+        t.Errorf("NearestUnexplored() = %v, %v, want the only unvisited tile (5,1)", got, ok)
+    }
+    
+    state.MarkExplored(5, 1)
+    if got, ok := state.NearestUnexplored(Position{X: 1, Y: 1}); ok {
+        t.Errorf("NearestUnexplored() = %v with everything explored, want false", got)
+    }
+}
+
+func TestNearestUnexploredGoesByWalkingDistance(t *testing.T) {
+    // Both tiles are two cells away, but (3,1) is a six-step walk round the wall
+    state := parseGrid(
+        "#####",
+        "#.#.#",
+        "#.#.#",
+        "#...#",
+        "#####",
+    )
+    exploreAll(state)
+    state.Grid[1][3].Visited = false
+    state.Grid[3][1].Visited = false
+    
+    if got, ok := state.NearestUnexplored(Position{X: 1, Y: 1}); !ok || got != (Position{X: 1, Y: 3}) {
+        t.Errorf("NearestUnexplored() = %v, %v, want (1,3)", got, ok)
+    }
+}
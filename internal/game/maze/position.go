@@ -9,4 +9,10 @@ type Position struct {
 // NewPosition creates a new position
 func NewPosition(x, y int) Position {
     return Position{X: x, Y: y}
+}
+
+// ManhattanDistance returns the number of orthogonal steps between two positions,
+// ignoring walls
+func (p Position) ManhattanDistance(other Position) int {
+    return abs(p.X-other.X) + abs(p.Y-other.Y)
 }
\ No newline at end of file
@@ -0,0 +1,116 @@
+// internal/game/maze/room.go
+package maze
+
+import (
+    "math/rand"
+)
+
+// DefaultRoomMinSize and DefaultRoomMaxSize bound the sides of carved rooms, in tiles
+const (
+    DefaultRoomMinSize = 3
+    DefaultRoomMaxSize = 5
+)
+
+// maxRoomAttempts stops the search for a free spot once the maze is too
+// crowded for another room
+const maxRoomAttempts = 50
+
+// Room is a rectangle of open floor carved into the corridors
+type Room struct {
+    X, Y          int // Top left cell
+    Width, Height int // Size in tiles
+}
+
+// Contains checks if a cell lies inside the room
+func (r Room) Contains(x, y int) bool {
+    return x >= r.X && x < r.X+r.Width && y >= r.Y && y < r.Y+r.Height
+}
+
+// overlaps checks if two rooms share or touch any cell. Keeping a wall
+// between rooms stops them merging into one bigger room
+func (r Room) overlaps(other Room) bool {
+    return r.X <= other.X+other.Width && other.X <= r.X+r.Width &&
+        r.Y <= other.Y+other.Height && other.Y <= r.Y+r.Height
+}
+
+// roomSizes returns the smallest and largest room sides to pick from.
+// Rooms are at least 2 tiles a side, so each one takes in a corridor cell
+func (g *Generator) roomSizes() (int, int) {
+    minSize, maxSize := g.RoomMinSize, g.RoomMaxSize
+    if maxSize < 2 {
+        maxSize = DefaultRoomMaxSize
+    }
+    if minSize < 2 || minSize > maxSize {
+        minSize = min(DefaultRoomMinSize, maxSize)
+    }
+    return minSize, maxSize
+}
+
+// carveRooms opens up RoomCount rectangles of floor inside the wall frame and
+// left of maxX. A room that can't be reached from start is walled up again,
+// so every room kept joins the corridors. The rooms kept are in g.Rooms
+func (g *Generator) carveRooms(state *State, start Position, maxX int, r *rand.Rand) {
+    g.Rooms = nil
+    minSize, maxSize := g.roomSizes()
+    
+    for attempt := 0; attempt < maxRoomAttempts && len(g.Rooms) < g.RoomCount; attempt++ {
+        room := Room{
+            Width:  minSize + r.Intn(maxSize-minSize+1),
+            Height: minSize + r.Intn(maxSize-minSize+1),
+        }
+        
+        // The room has to fit between the wall frame and maxX
+        spanX := min(maxX, state.Width-state.Border) - state.Border - room.Width
+        spanY := state.Height - 2*state.Border - room.Height
+        if spanX < 0 || spanY < 0 {
+            continue
+        }
+        room.X = state.Border + r.Intn(spanX+1)
+        room.Y = state.Border + r.Intn(spanY+1)
+        if room.Contains(start.X, start.Y) || g.overlapsRoom(room) {
+            continue
+        }
+        
+        // Carve it, remembering the walls in case it has to be undone
+        var opened []Position
+        for y := room.Y; y < room.Y+room.Height; y++ {
+            for x := room.X; x < room.X+room.Width; x++ {
+                if state.GetTile(x, y).Type == Wall {
+                    state.SetTileType(x, y, Floor)
+                    opened = append(opened, Position{X: x, Y: y})
+                }
+            }
+        }
+        
+        if !g.hasPath(state, start.X, start.Y, room.X, room.Y) {
+            for _, cell := range opened {
+                state.SetTileType(cell.X, cell.Y, Wall)
+            }
+            continue
+        }
+        g.Rooms = append(g.Rooms, room)
+    }
+}
+
+// overlapsRoom checks if a room would overlap or touch one already carved
+func (g *Generator) overlapsRoom(room Room) bool {
+    for _, other := range g.Rooms {
+        if room.overlaps(other) {
+            return true
+        }
+    }
+    return false
+}
+
+// reflectRooms adds the mirror image of every carved room to g.Rooms once
+// the carved half has been reflected onto the other
+func (g *Generator) reflectRooms(state *State) {
+    for _, room := range g.Rooms {
+        a := g.Symmetry.Reflect(Position{X: room.X, Y: room.Y}, state.Width, state.Height)
+        b := g.Symmetry.Reflect(Position{X: room.X + room.Width - 1, Y: room.Y + room.Height - 1}, state.Width, state.Height)
+        reflected := Room{X: min(a.X, b.X), Y: min(a.Y, b.Y), Width: room.Width, Height: room.Height}
+        if reflected != room {
+            g.Rooms = append(g.Rooms, reflected)
+        }
+    }
+}
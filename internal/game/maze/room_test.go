@@ -0,0 +1,46 @@
+// internal/game/maze/room_test.go
+package maze
+
+import "testing"
+
+func TestRoomsAreOpenAndReachable(t *testing.T) {
+    for seed := int64(1); seed <= 20; seed++ {
+        g := newTestGenerator(seed)
+        g.RoomCount = 3
+        state := g.Generate(31, 31)
+        
+        if len(g.Rooms) != g.RoomCount {
+            t.Fatalf("seed %d: %d rooms carved, want %d", seed, len(g.Rooms), g.RoomCount)
+        }
+        minSize, maxSize := g.roomSizes()
+        for i, room := range g.Rooms {
+            if room.Width < minSize || room.Width > maxSize || room.Height < minSize || room.Height > maxSize {
+                t.Errorf("seed %d: room %+v is outside the %d-%d size range", seed, room, minSize, maxSize)
+            }
+            for y := room.Y; y < room.Y+room.Height; y++ {
+                for x := room.X; x < room.X+room.Width; x++ {
+                    if !state.InInterior(x, y) || state.Grid[y][x].IsWall() {
+                        t.Errorf("seed %d: room %+v has a wall or border cell at (%d,%d)", seed, room, x, y)
+                    }
+                }
+            }
+            if !g.hasPath(state, state.Start.X, state.Start.Y, room.X, room.Y) {
+                t.Errorf("seed %d: room %+v can't be reached from the start", seed, room)
+            }
+            for _, other := range g.Rooms[i+1:] {
+                if room.overlaps(other) {
+                    t.Errorf("seed %d: rooms %+v and %+v touch", seed, room, other)
+                }
+            }
+        }
+    }
+}
+
+func TestZeroRoomCountCarvesNone(t *testing.T) {
+    g := newTestGenerator(1)
+    g.RoomCount = 0
+    g.Generate(21, 21)
+    if len(g.Rooms) != 0 {
+        t.Errorf("%d rooms carved with RoomCount 0", len(g.Rooms))
+    }
+}
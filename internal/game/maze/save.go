@@ -0,0 +1,89 @@
+// internal/game/maze/save.go
+package maze
+
+import (
+    "fmt"
+)
+
+// SavedTile is one grid cell of a SavedState
+type SavedTile struct {
+    ID          int      `json:"id"`
+    Type        TileType `json:"type"`
+    FlavorImage string   `json:"flavor,omitempty"`
+    Visited     bool     `json:"visited,omitempty"`
+    VisitCount  int      `json:"visits,omitempty"`
+}
+
+// SavedState is a maze grid as plain data that can be encoded for saves.
+// Tiles are listed row by row
+type SavedState struct {
+    Width       int           `json:"width"`
+    Height      int           `json:"height"`
+    Border      int           `json:"border"`
+    GoalX       int           `json:"goalX"`
+    GoalY       int           `json:"goalY"`
+    Start       Position      `json:"start"`
+    Spawns      []Position    `json:"spawns"`
+    Tiles       []SavedTile   `json:"tiles"`
+    Teleporters [][2]Position `json:"teleporters"` // Each teleporter and its partner, both ways round
+}
+
+// Save returns a copy of the grid as it is now, rotations and all
+func (s *State) Save() SavedState {
+    saved := SavedState{
+        Width:  s.Width,
+        Height: s.Height,
+        Border: s.Border,
+        GoalX:  s.GoalX,
+        GoalY:  s.GoalY,
+        Start:  s.Start,
+        Spawns: append([]Position(nil), s.Spawns...),
+        Tiles:  make([]SavedTile, 0, s.Width*s.Height),
+    }
+    for y := 0; y < s.Height; y++ {
+        for x := 0; x < s.Width; x++ {
+            tile := s.Grid[y][x]
+            saved.Tiles = append(saved.Tiles, SavedTile{
+                ID:          tile.ID,
+                Type:        tile.Type,
+                FlavorImage: tile.FlavorImage,
+                Visited:     tile.Visited,
+                VisitCount:  tile.VisitCount,
+            })
+        }
+    }
+    for from, to := range s.TeleportTargets {
+        saved.Teleporters = append(saved.Teleporters, [2]Position{from, to})
+    }
+    return saved
+}
+
+// LoadState rebuilds a grid saved with Save.
+// Returns an error if the tiles don't fill the saved size
+func LoadState(saved SavedState) (*State, error) {
+    if saved.Width <= 0 || saved.Height <= 0 || len(saved.Tiles) != saved.Width*saved.Height {
+        return nil, fmt.Errorf("saved maze has %d tiles for a %dx%d grid", len(saved.Tiles), saved.Width, saved.Height)
+    }
+    
+    state := NewStateWithBorder(saved.Width, saved.Height, saved.Border)
+    state.GoalX = saved.GoalX
+    state.GoalY = saved.GoalY
+    state.Start = saved.Start
+    state.Spawns = append([]Position(nil), saved.Spawns...)
+    for i, tile := range saved.Tiles {
+        x, y := i%saved.Width, i/saved.Width
+        state.Grid[y][x] = &Tile{
+            ID:          tile.ID,
+            Type:        tile.Type,
+            FlavorImage: tile.FlavorImage,
+            X:           x,
+            Y:           y,
+            Visited:     tile.Visited,
+            VisitCount:  tile.VisitCount,
+        }
+    }
+    for _, pair := range saved.Teleporters {
+        state.TeleportTargets[pair[0]] = pair[1]
+    }
+    return state, nil
+}
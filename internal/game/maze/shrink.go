@@ -0,0 +1,99 @@
+// internal/game/maze/shrink.go
+package maze
+
+// MinShrinkInterior is the narrowest the walkable interior gets before
+// shrinking stops
+const MinShrinkInterior = 3
+
+// CanShrink checks if another ring can be walled without closing the
+// interior below MinShrinkInterior
+func (s *State) CanShrink() bool {
+    next := s.Border + 1
+    return s.Width-2*next >= MinShrinkInterior && s.Height-2*next >= MinShrinkInterior
+}
+
+// ringCells returns the cells of the ring the given number of tiles in from the edge
+func (s *State) ringCells(ring int) []Position {
+    cells := []Position{}
+    for y := ring; y < s.Height-ring; y++ {
+        for x := ring; x < s.Width-ring; x++ {
+            if x == ring || x == s.Width-1-ring || y == ring || y == s.Height-1-ring {
+                cells = append(cells, Position{X: x, Y: y})
+            }
+        }
+    }
+    return cells
+}
+
+// ShrinkOneRing walls the outermost ring inside the wall frame and grows the
+// frame to cover it, so rotations leave it alone. The goal tile is kept open,
+// teleporters in the ring lose their partner, and a start caught in the ring
+// moves to the nearest floor further in.
+// Returns the cells that were walled, nil if the maze can't shrink any more
+func (s *State) ShrinkOneRing() []Position {
+    if !s.CanShrink() {
+        return nil
+    }
+    
+    walled := []Position{}
+    for _, cell := range s.ringCells(s.Border) {
+        tile := s.Grid[cell.Y][cell.X]
+        if tile.IsWall() || tile.IsGoal() {
+            continue
+        }
+        
+        if partner, ok := s.TeleportTargets[cell]; ok {
+            delete(s.TeleportTargets, cell)
+            delete(s.TeleportTargets, partner)
+            if partnerTile := s.GetTile(partner.X, partner.Y); partnerTile != nil && partnerTile.IsTeleporter() {
+                partnerTile.Type = Floor
+            }
+        }
+        
+        tile.Type = Wall
+        tile.Highlighted = false
+        walled = append(walled, cell)
+    }
+    s.Border++
+    s.dirty = true
+    
+    if tile := s.GetTile(s.Start.X, s.Start.Y); tile == nil || tile.IsWall() {
+        if start, ok := s.NearestFloor(s.Start, nil); ok {
+            s.Start = start
+        }
+    }
+    return walled
+}
+
+// ShrinkOneRing closes the maze in by one ring. Entities standing in the
+// walled ring are pushed to the nearest free floor further in, and any that
+// lost their way to the goal get a connector carved.
+// Returns the entities' positions after the shrink, in the order given,
+// and false if the maze couldn't shrink
+func (m *Maze) ShrinkOneRing(entities []Position) ([]Position, bool) {
+    if m.State.ShrinkOneRing() == nil {
+        return entities, false
+    }
+    
+    placed := make([]Position, len(entities))
+    taken := make(map[Position]bool)
+    for i, pos := range entities {
+        if m.State.IsValidMove(pos.X, pos.Y) {
+            placed[i] = pos
+            taken[pos] = true
+        }
+    }
+    for i, pos := range entities {
+        if m.State.IsValidMove(pos.X, pos.Y) {
+            continue
+        }
+        if inner, ok := m.State.NearestFloor(pos, taken); ok {
+            pos = inner
+        }
+        placed[i] = pos
+        taken[pos] = true
+    }
+    
+    m.EnsureSolvable(placed...)
+    return placed, true
+}
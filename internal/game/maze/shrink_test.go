@@ -0,0 +1,86 @@
+// internal/game/maze/shrink_test.go
+package maze
+
+import "testing"
+
+func TestShrinkWallsTheOutermostRing(t *testing.T) {
+    m := newTestMaze(
+        "#########",
+        "#.......#",
+        "#.......#",
+        "#.......#",
+        "#...G...#",
+        "#.......#",
+        "#.......#",
+        "#.......#",
+        "#########",
+    )
+    
+    walled := m.State.ShrinkOneRing()
+    if len(walled) != 24 {
+        t.Errorf("%d cells walled, want the 24 of the ring one in from the edge", len(walled))
+    }
+    if m.State.Border != 2 {
+        t.Errorf("border = %d after a shrink, want 2", m.State.Border)
+    }
+    for y := 0; y < m.State.Height; y++ {
+        for x := 0; x < m.State.Width; x++ {
+            inside := x >= 2 && x <= 6 && y >= 2 && y <= 6
+            if wall := m.State.Grid[y][x].IsWall(); wall == inside {
+                t.Errorf("(%d,%d) wall = %v after the shrink", x, y, wall)
+            }
+        }
+    }
+}
+
+func TestShrinkRelocatesTrappedEntities(t *testing.T) {
+    m := newTestMaze(
+        "#########",
+        "#.......#",
+        "#.......#",
+        "#.......#",
+        "#...G...#",
+        "#.......#",
+        "#.......#",
+        "#.......#",
+        "#########",
+    )
+    m.State.Start = Position{X: 1, Y: 1}
+    entities := []Position{{X: 1, Y: 1}, {X: 7, Y: 4}, {X: 3, Y: 3}}
+    
+    placed, ok := m.ShrinkOneRing(entities)
+    if !ok {
+        t.Fatal("a 9x9 maze should have room to shrink")
+    }
+    if placed[0] != (Position{X: 2, Y: 2}) || placed[1] != (Position{X: 6, Y: 4}) {
+        t.Errorf("trapped entities moved to %v and %v, want the nearest inner floor (2,2) and (6,4)", placed[0], placed[1])
+    }
+    if placed[2] != entities[2] {
+        t.Errorf("an entity already inside moved from %v to %v", entities[2], placed[2])
+    }
+    if m.State.Start != (Position{X: 2, Y: 2}) {
+        t.Errorf("start = %v, want it moved off the walled ring", m.State.Start)
+    }
+    for _, pos := range placed {
+        if !m.CanReachGoal(pos) {
+            t.Errorf("%v can't reach the goal after the shrink", pos)
+        }
+    }
+}
+
+func TestShrinkStopsAtTheMinimumInterior(t *testing.T) {
+    m := newTestMaze(
+        "#######",
+        "#.....#",
+        "#..G..#",
+        "#.....#",
+        "#######",
+    )
+    
+    if _, ok := m.ShrinkOneRing([]Position{{X: 1, Y: 1}}); ok {
+        t.Error("shrinking a three-tall interior would close it below the minimum")
+    }
+    if m.State.Border != 1 || m.IsWall(1, 1) {
+        t.Error("a refused shrink changed the maze")
+    }
+}
@@ -0,0 +1,54 @@
+// internal/game/maze/sight.go
+package maze
+
+// LineOfSight checks if nothing but floor lies between two cells on the
+// live grid, tracing a Bresenham line. The end cells themselves never block,
+// so walls can be seen but not seen through. The result is the same in both
+// directions because the line is always traced from the same end
+func (s *State) LineOfSight(from, to Position) bool {
+    if s.GetTile(from.X, from.Y) == nil || s.GetTile(to.X, to.Y) == nil {
+        return false
+    }
+    
+    // Always trace from the lower cell so the sightline is symmetric
+    if to.Y < from.Y || (to.Y == from.Y && to.X < from.X) {
+        from, to = to, from
+    }
+    
+    dx := abs(to.X - from.X)
+    dy := -abs(to.Y - from.Y)
+    stepX, stepY := 1, 1
+    if to.X < from.X {
+        stepX = -1
+    }
+    if to.Y < from.Y {
+        stepY = -1
+    }
+    
+    x, y := from.X, from.Y
+    err := dx + dy
+    for x != to.X || y != to.Y {
+        // Step to the next cell on the line
+        e2 := 2 * err
+        if e2 >= dy {
+            err += dy
+            x += stepX
+        }
+        if e2 <= dx {
+            err += dx
+            y += stepY
+        }
+        
+        // Any wall before the target blocks the view
+        if (x != to.X || y != to.Y) && s.GetTile(x, y).IsWall() {
+            return false
+        }
+    }
+    
+    return true
+}
+
+// LineOfSight checks if the two cells can see each other on the live grid
+func (m *Maze) LineOfSight(from, to Position) bool {
+    return m.State.LineOfSight(from, to)
+}
@@ -0,0 +1,38 @@
+// internal/game/maze/sight_test.go
+package maze
+
+import "testing"
+
+func TestLineOfSight(t *testing.T) {
+    state := parseGrid(
+        "#######",
+        "#.....#",
+        "#..#..#",
+        "#.....#",
+        "#.#...#",
+        "#.....#",
+        "#######",
+    )
+    
+    tests := []struct {
+        name     string
+        from, to Position
+        want     bool
+    }{
+        {"open corridor", Position{X: 1, Y: 1}, Position{X: 5, Y: 1}, true},
+        {"wall in between", Position{X: 2, Y: 2}, Position{X: 4, Y: 2}, false},
+        {"open diagonal", Position{X: 1, Y: 1}, Position{X: 4, Y: 4}, true},
+        {"blocked diagonal", Position{X: 1, Y: 3}, Position{X: 3, Y: 5}, false},
+        {"wall itself is visible", Position{X: 1, Y: 2}, Position{X: 3, Y: 2}, true},
+        {"outside the grid", Position{X: 1, Y: 1}, Position{X: 9, Y: 1}, false},
+    }
+    
+    for _, tt := range tests {
+        if got := state.LineOfSight(tt.from, tt.to); got != tt.want {
+            t.Errorf("%s: LineOfSight(%v, %v) = %v, want %v", tt.name, tt.from, tt.to, got, tt.want)
+        }
+        if got := state.LineOfSight(tt.to, tt.from); got != tt.want {
+            t.Errorf("%s: LineOfSight(%v, %v) = %v the other way, want %v", tt.name, tt.to, tt.from, got, tt.want)
+        }
+    }
+}
@@ -1,5 +1,9 @@
 package maze
 
+import (
+    "math/rand"
+)
+
 // State manages the current state of the maze
 type State struct {
     Grid      [][]*Tile
@@ -7,10 +11,28 @@ type State struct {
     Height    int
     GoalX     int
     GoalY     int
+    Start     Position   // Carved start cell where the player spawns
+    Spawns    []Position // Floor cells where NPCs spawn
+    Border    int        // Thickness of the wall frame that is never carved or rotated
+    
+    // Each teleporter cell mapped to its partner, in both directions
+    TeleportTargets map[Position]Position
+    
+    dirty bool // Set whenever the grid's look changes, until ClearDirty
 }
 
 // NewState creates a new maze state with the given dimensions
 func NewState(width, height int) *State {
+    return NewStateWithBorder(width, height, DefaultBorderThickness)
+}
+
+// NewStateWithBorder creates a new maze state with a wall frame of the given
+// thickness. Thicknesses below 1 fall back to DefaultBorderThickness
+func NewStateWithBorder(width, height, border int) *State {
+    if border < 1 {
+        border = DefaultBorderThickness
+    }
+    
     grid := make([][]*Tile, height)
     for y := range grid {
         grid[y] = make([]*Tile, width)
@@ -27,7 +49,58 @@ func NewState(width, height int) *State {
         Height: height,
         GoalX:  -1, // To be set later
         GoalY:  -1, // To be set later
+        Border: border,
+        
+        TeleportTargets: make(map[Position]Position),
+        dirty:           true,
+    }
+}
+
+// MarkDirty flags the grid as changed since it was last drawn
+func (s *State) MarkDirty() {
+    s.dirty = true
+}
+
+// Dirty checks if the grid has changed since ClearDirty was last called,
+// so a cached picture of it has to be redrawn
+func (s *State) Dirty() bool {
+    return s.dirty
+}
+
+// ClearDirty records that the current grid has been drawn
+func (s *State) ClearDirty() {
+    s.dirty = false
+}
+
+// InInterior checks if a cell lies inside the wall frame
+func (s *State) InInterior(x, y int) bool {
+    return x >= s.Border && x < s.Width-s.Border && y >= s.Border && y < s.Height-s.Border
+}
+
+// interiorColumns returns the columns inside the wall frame, skipping skipX
+func (s *State) interiorColumns(skipX int) []int {
+    columns := []int{}
+    for x := s.Border; x < s.Width-s.Border; x++ {
+        if x != skipX {
+            columns = append(columns, x)
+        }
     }
+    return columns
+}
+
+// RotationAffectedCells returns the cells a rotation or shuffle of the
+// player's row moves: the row's interior minus the player's own tile.
+// Rows in the wall frame have none
+func (s *State) RotationAffectedCells(playerX, playerY int) []Position {
+    if playerY < s.Border || playerY >= s.Height-s.Border {
+        return nil
+    }
+    
+    cells := []Position{}
+    for _, x := range s.interiorColumns(playerX) {
+        cells = append(cells, Position{X: x, Y: playerY})
+    }
+    return cells
 }
 
 // GetTile returns the tile at the specified position
@@ -44,6 +117,7 @@ func (s *State) SetTileType(x, y int, tileType TileType) {
         return
     }
     s.Grid[y][x].Type = tileType
+    s.dirty = true
     
     // If setting a goal tile, update the goal position
     if tileType == Goal {
@@ -52,6 +126,139 @@ func (s *State) SetTileType(x, y int, tileType TileType) {
     }
 }
 
+// NearestFloor finds the floor tile closest to the requested position
+// (searching outward through the grid) that isn't in the taken set.
+// Requests outside the grid are clamped to its edge first
+func (s *State) NearestFloor(request Position, taken map[Position]bool) (Position, bool) {
+    if s.Width == 0 || s.Height == 0 {
+        return Position{}, false
+    }
+    
+    // Clamp the request onto the grid
+    start := Position{X: min(max(request.X, 0), s.Width-1), Y: min(max(request.Y, 0), s.Height-1)}
+    
+    visited := map[Position]bool{start: true}
+    queue := []Position{start}
+    
+    // Directions: North, East, South, West
+    dx := []int{0, 1, 0, -1}
+    dy := []int{-1, 0, 1, 0}
+    
+    for len(queue) > 0 {
+        current := queue[0]
+        queue = queue[1:]
+        
+        if s.Grid[current.Y][current.X].IsFloor() && !taken[current] {
+            return current, true
+        }
+        
+        // Walls don't block the search - we want the nearest floor by distance
+        for d := 0; d < 4; d++ {
+            next := Position{X: current.X + dx[d], Y: current.Y + dy[d]}
+            if s.GetTile(next.X, next.Y) == nil || visited[next] {
+                continue
+            }
+            visited[next] = true
+            queue = append(queue, next)
+        }
+    }
+    
+    return Position{}, false
+}
+
+// AddTeleporterPair turns two walkable cells into teleporters linked to each other
+func (s *State) AddTeleporterPair(a, b Position) {
+    if a == b || s.GetTile(a.X, a.Y) == nil || s.GetTile(b.X, b.Y) == nil {
+        return
+    }
+    s.SetTileType(a.X, a.Y, Teleporter)
+    s.SetTileType(b.X, b.Y, Teleporter)
+    s.TeleportTargets[a] = b
+    s.TeleportTargets[b] = a
+}
+
+// TeleportTarget returns the partner of the teleporter at the specified position
+func (s *State) TeleportTarget(x, y int) (Position, bool) {
+    tile := s.GetTile(x, y)
+    if tile == nil || !tile.IsTeleporter() {
+        return Position{}, false
+    }
+    target, ok := s.TeleportTargets[Position{X: x, Y: y}]
+    return target, ok
+}
+
+// RecordVisit counts an arrival on the tile at the specified position
+func (s *State) RecordVisit(x, y int) {
+    if tile := s.GetTile(x, y); tile != nil {
+        tile.RecordVisit()
+        s.dirty = true // Visits tint the heatmap
+    }
+}
+
+// MarkExplored flags the tile at the specified position as visited by the player
+// Returns true if the tile had not been explored before
+func (s *State) MarkExplored(x, y int) bool {
+    tile := s.GetTile(x, y)
+    if tile == nil || tile.IsWall() || tile.Visited {
+        return false
+    }
+    tile.Visited = true
+    return true
+}
+
+// FloorTileCount returns the number of tiles that can be walked on
+func (s *State) FloorTileCount() int {
+    count := 0
+    for y := 0; y < s.Height; y++ {
+        for x := 0; x < s.Width; x++ {
+            if s.Grid[y][x] != nil && !s.Grid[y][x].IsWall() {
+                count++
+            }
+        }
+    }
+    return count
+}
+
+// TilesOfType returns the positions of every tile of the given type on the
+// live grid, row by row, so they follow the tiles through rotations
+func (s *State) TilesOfType(tileType TileType) []Position {
+    positions := []Position{}
+    for y := 0; y < s.Height; y++ {
+        for x := 0; x < s.Width; x++ {
+            if s.Grid[y][x] != nil && s.Grid[y][x].Type == tileType {
+                positions = append(positions, Position{X: x, Y: y})
+            }
+        }
+    }
+    return positions
+}
+
+// CountType returns how many tiles of the given type are on the grid
+func (s *State) CountType(tileType TileType) int {
+    count := 0
+    for y := 0; y < s.Height; y++ {
+        for x := 0; x < s.Width; x++ {
+            if s.Grid[y][x] != nil && s.Grid[y][x].Type == tileType {
+                count++
+            }
+        }
+    }
+    return count
+}
+
+// MaxVisitCount returns the highest visit count of any tile
+func (s *State) MaxVisitCount() int {
+    maxCount := 0
+    for y := 0; y < s.Height; y++ {
+        for x := 0; x < s.Width; x++ {
+            if s.Grid[y][x] != nil && s.Grid[y][x].VisitCount > maxCount {
+                maxCount = s.Grid[y][x].VisitCount
+            }
+        }
+    }
+    return maxCount
+}
+
 // IsValidMove checks if a move to the given coordinates is valid
 func (s *State) IsValidMove(x, y int) bool {
     tile := s.GetTile(x, y)
@@ -63,22 +270,11 @@ func (s *State) HighlightXRotation(playerX, playerY int) {
     // Clear any existing highlights first
     s.ClearHighlights()
 
-    // Highlight all tiles in the same row as the player (excluding the player's position)
-    if playerY < 0 || playerY >= s.Height {
-        return
-    }
-    
-    for x := 0; x < s.Width; x++ {
-        // Skip player position
-        if x == playerX {
-            continue
-        }
-
-        // Only highlight tiles that can be rotated (not walls at the edge)
-        if x > 0 && x < s.Width-1 {
-            s.Grid[playerY][x].Highlighted = true
-        }
+    // Highlight the tiles of the player's row that a rotation would move
+    for _, cell := range s.RotationAffectedCells(playerX, playerY) {
+        s.Grid[cell.Y][cell.X].Highlighted = true
     }
+    s.dirty = true
 }
 
 // ClearHighlights removes all highlighting
@@ -90,43 +286,157 @@ func (s *State) ClearHighlights() {
             }
         }
     }
+    s.dirty = true
 }
 
-// PerformXRotate performs the rotation of tiles on the X-axis
-func (s *State) PerformXRotate(playerX, playerY, direction int) {
-    if playerY < 0 || playerY >= s.Height {
+// Row returns a copy of the tiles in the given row
+func (s *State) Row(y int) []*Tile {
+    if y < 0 || y >= s.Height {
+        return nil
+    }
+    row := make([]*Tile, s.Width)
+    copy(row, s.Grid[y])
+    return row
+}
+
+// SetRow replaces the tiles in the given row and updates their positions.
+// Teleporters that move keep their pairing
+func (s *State) SetRow(y int, row []*Tile) {
+    if y < 0 || y >= s.Height || len(row) != s.Width {
         return
     }
-    
-    // Create a copy of the current row for rotation
-    tempRow := make([]*Tile, s.Width)
-    for x := 0; x < s.Width; x++ {
-        tempRow[x] = s.Grid[playerY][x]
+    moved := map[Position]Position{}
+    for x, tile := range row {
+        if tile.IsTeleporter() && (tile.X != x || tile.Y != y) {
+            moved[Position{X: tile.X, Y: tile.Y}] = Position{X: x, Y: y}
+        }
+        s.Grid[y][x] = tile
+        tile.X = x
+        tile.Y = y
     }
+    s.moveTeleporters(moved)
+    s.dirty = true
+}
 
-    // Perform the rotation for each tile (except boundary walls)
-    for x := 1; x < s.Width-1; x++ {
-        // Skip the player's position
-        if x == playerX {
-            continue
-        }
+// SnapshotRow returns copies of the tiles in the given row, so the row can
+// later be put back exactly with RestoreRow
+func (s *State) SnapshotRow(y int) []Tile {
+    if y < 0 || y >= s.Height {
+        return nil
+    }
+    tiles := make([]Tile, s.Width)
+    for x, tile := range s.Grid[y] {
+        tiles[x] = *tile
+    }
+    return tiles
+}
 
-        // Calculate new position
-        newX := x + direction
+// RestoreRow puts back a row saved with SnapshotRow. Teleporters in the row
+// return to their saved cells and keep their pairing
+func (s *State) RestoreRow(y int, tiles []Tile) {
+    if y < 0 || y >= s.Height || len(tiles) != s.Width {
+        return
+    }
+    saved := make(map[int]Position, len(tiles))
+    for x, tile := range tiles {
+        saved[tile.ID] = Position{X: x, Y: y}
+    }
+    moved := map[Position]Position{}
+    for x, tile := range s.Grid[y] {
+        if to, ok := saved[tile.ID]; ok && tile.IsTeleporter() && to.X != x {
+            moved[Position{X: x, Y: y}] = to
+        }
+    }
+    for x := range tiles {
+        tile := tiles[x]
+        s.Grid[y][x] = &tile
+    }
+    s.moveTeleporters(moved)
+    s.dirty = true
+}
 
-        // Handle wrapping within the playable area (excluding boundary walls)
-        if newX <= 0 {
-            newX = s.Width - 2
-        } else if newX >= s.Width-1 {
-            newX = 1
+// moveTeleporters re-keys the teleporter pairs after their tiles have moved
+func (s *State) moveTeleporters(moved map[Position]Position) {
+    if len(moved) == 0 {
+        return
+    }
+    relocate := func(p Position) Position {
+        if to, ok := moved[p]; ok {
+            return to
         }
+        return p
+    }
+    
+    targets := make(map[Position]Position, len(s.TeleportTargets))
+    for from, to := range s.TeleportTargets {
+        targets[relocate(from)] = relocate(to)
+    }
+    s.TeleportTargets = targets
+}
+
+// SimulateShuffleRow returns the player's row with its interior tiles
+// (excluding the boundary walls and the player's own tile) randomly permuted.
+// The grid itself is not modified
+func (s *State) SimulateShuffleRow(playerX, playerY int, r *rand.Rand) []*Tile {
+    row := s.Row(playerY)
+    if row == nil {
+        return nil
+    }
+    
+    // Collect the cells that take part in the shuffle
+    cells := s.RotationAffectedCells(playerX, playerY)
+    
+    // Permute the tiles across those cells
+    tiles := make([]*Tile, len(cells))
+    for i, cell := range cells {
+        tiles[i] = row[cell.X]
+    }
+    r.Shuffle(len(tiles), func(i, j int) {
+        tiles[i], tiles[j] = tiles[j], tiles[i]
+    })
+    for i, cell := range cells {
+        row[cell.X] = tiles[i]
+    }
+    
+    return row
+}
 
-        // Move the tile
-        s.Grid[playerY][newX] = tempRow[x]
-        // Update the tile's position
-        s.Grid[playerY][newX].X = newX
+// SimulateXRotate returns the player's row as it would look after an
+// X-rotation. Interior tiles shift one column in the given direction,
+// wrapping inside the boundary walls and skipping over the player's own
+// tile, which stays put. The grid itself is not modified
+func (s *State) SimulateXRotate(playerX, playerY, direction int) []*Tile {
+    row := s.Row(playerY)
+    if row == nil {
+        return nil
     }
+    
+    // Collect the cells that take part in the rotation
+    cells := s.RotationAffectedCells(playerX, playerY)
+    if len(cells) < 2 || direction == 0 {
+        return row
+    }
+    
+    // Each tile moves to the next rotating cell, wrapping around
+    rotated := make([]*Tile, len(row))
+    copy(rotated, row)
+    for i, cell := range cells {
+        target := cells[(i+direction%len(cells)+len(cells))%len(cells)]
+        rotated[target.X] = row[cell.X]
+    }
+    
+    return rotated
+}
+
+// PerformXRotate performs the rotation of tiles on the X-axis
+func (s *State) PerformXRotate(playerX, playerY, direction int) {
+    rotated := s.SimulateXRotate(playerX, playerY, direction)
+    if rotated == nil {
+        return
+    }
+    
+    s.SetRow(playerY, rotated)
 
     // Clear highlights after rotation
     s.ClearHighlights()
-}
\ No newline at end of file
+}
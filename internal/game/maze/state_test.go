@@ -0,0 +1,194 @@
+// internal/game/maze/state_test.go
+package maze
+
+import (
+    "math/rand"
+    "testing"
+)
+
+// parseGrid builds a state from rows of '#' (wall), '.' (floor), 'G' (goal),
+// 'T' (trap) and 'S' (special trigger). Cells outside the rows are walls
+func parseGrid(rows ...string) *State {
+    state := NewState(len(rows[0]), len(rows))
+    for y, row := range rows {
+        for x, c := range row {
+            switch c {
+            case '.':
+                state.SetTileType(x, y, Floor)
+            case 'G':
+                state.SetTileType(x, y, Goal)
+                state.GoalX, state.GoalY = x, y
+            case 'T':
+                state.SetTileType(x, y, Trap)
+            case 'S':
+                state.SetTileType(x, y, SpecialTrigger)
+            }
+        }
+    }
+    return state
+}
+
+// newTestMaze wraps a parsed grid in a maze with a seeded random source
+func newTestMaze(rows ...string) *Maze {
+    state := parseGrid(rows...)
+    return &Maze{
+        State:     state,
+        Generator: newTestGenerator(1),
+        Rand:      rand.New(rand.NewSource(1)),
+        TileSize:  TileSize,
+    }
+}
+
+func TestRecordVisitIncrementsCount(t *testing.T) {
+    state := parseGrid(
+        "#####",
+        "#...#",
+        "#####",
+    )
+
+    state.RecordVisit(2, 1)
+    state.RecordVisit(2, 1)
+
+    if got := state.GetTile(2, 1).VisitCount; got != 2 {
+        t.Errorf("VisitCount = %d, want 2", got)
+    }
+    if got := state.MaxVisitCount(); got != 2 {
+        t.Errorf("MaxVisitCount() = %d, want 2", got)
+    }
+}
+
+func TestVisitCountsMoveWithRotatedTiles(t *testing.T) {
+    state := parseGrid(
+        "######",
+        "#....#",
+        "######",
+    )
+    state.RecordVisit(2, 1)
+    state.RecordVisit(2, 1)
+    state.RecordVisit(3, 1)
+
+    // The player stands on (1,1), so (2,1), (3,1) and (4,1) shift right
+    state.PerformXRotate(1, 1, 1)
+
+    want := []int{0, 0, 0, 2, 1}
+    for x := 1; x <= 4; x++ {
+        if got := state.GetTile(x, 1).VisitCount; got != want[x] {
+            t.Errorf("VisitCount at (%d,1) = %d, want %d", x, got, want[x])
+        }
+    }
+}
+
+func TestSimulateXRotateMatchesPerform(t *testing.T) {
+    rows := []string{
+        "#########",
+        "#.#T.S#.#",
+        "#......G#",
+        "#########",
+    }
+    for _, direction := range []int{1, -1} {
+        state := parseGrid(rows...)
+        before := rowTypes(state, 1)
+        
+        preview := state.SimulateXRotate(1, 1, direction)
+        if got := rowTypes(state, 1); !equalTypes(got, before) {
+            t.Fatalf("direction %d: simulating changed the row from %v to %v", direction, before, got)
+        }
+        
+        state.PerformXRotate(1, 1, direction)
+        after := rowTypes(state, 1)
+        if equalTypes(after, before) {
+            t.Fatalf("direction %d: the rotation didn't move anything", direction)
+        }
+        for x, tile := range preview {
+            if tile.Type != after[x] {
+                t.Errorf("direction %d: preview has %v at x=%d, the rotation left %v", direction, tile.Type, x, after[x])
+            }
+        }
+    }
+}
+
+// equalTypes reports whether two rows hold the same tile types
+func equalTypes(a, b []TileType) bool {
+    if len(a) != len(b) {
+        return false
+    }
+    for i := range a {
+        if a[i] != b[i] {
+            return false
+        }
+    }
+    return true
+}
+
+func TestFloorTileCountSkipsWalls(t *testing.T) {
+    state := parseGrid(
+        "######",
+        "#.T#G#",
+        "#.S..#",
+        "######",
+    )
+    
+    if got := state.FloorTileCount(); got != 7 {
+        t.Errorf("FloorTileCount() = %d, want 7", got)
+    }
+}
+
+func TestTeleportersLinkBothWays(t *testing.T) {
+    state := parseGrid(
+        "#######",
+        "#....G#",
+        "#######",
+    )
+    a, b := Position{X: 1, Y: 1}, Position{X: 4, Y: 1}
+    state.AddTeleporterPair(a, b)
+    
+    if target, ok := state.TeleportTarget(a.X, a.Y); !ok || target != b {
+        t.Errorf("TeleportTarget(%v) = %v, %v, want %v", a, target, ok, b)
+    }
+    if target, ok := state.TeleportTarget(b.X, b.Y); !ok || target != a {
+        t.Errorf("TeleportTarget(%v) = %v, %v, want %v", b, target, ok, a)
+    }
+    if _, ok := state.TeleportTarget(2, 1); ok {
+        t.Error("a plain floor tile shouldn't teleport")
+    }
+}
+
+func TestTilesOfTypeOnAHandBuiltGrid(t *testing.T) {
+    state := parseGrid(
+        "#######",
+        "#T..S.#",
+        "#.T..G#",
+        "#######",
+    )
+    
+    traps := state.TilesOfType(Trap)
+    want := []Position{{X: 1, Y: 1}, {X: 2, Y: 2}}
+    if len(traps) != len(want) || traps[0] != want[0] || traps[1] != want[1] {
+        t.Errorf("TilesOfType(Trap) = %v, want %v", traps, want)
+    }
+    counts := map[TileType]int{Trap: 2, SpecialTrigger: 1, Goal: 1, Floor: 6, Wall: 18}
+    for tileType, count := range counts {
+        if got := state.CountType(tileType); got != count {
+            t.Errorf("CountType(%v) = %d, want %d", tileType, got, count)
+        }
+    }
+}
+
+func TestTilesOfTypeFollowsARotation(t *testing.T) {
+    state := parseGrid(
+        "#######",
+        "#.T...#",
+        "#....G#",
+        "#######",
+    )
+    
+    // The player on (1,1) rotates the rest of the row right
+    state.PerformXRotate(1, 1, 1)
+    
+    if traps := state.TilesOfType(Trap); len(traps) != 1 || traps[0] != (Position{X: 3, Y: 1}) {
+        t.Errorf("TilesOfType(Trap) = %v after the rotation, want [(3,1)]", traps)
+    }
+    if got := state.CountType(Trap); got != 1 {
+        t.Errorf("CountType(Trap) = %d after the rotation, want 1", got)
+    }
+}
@@ -0,0 +1,173 @@
+// internal/game/maze/symmetry.go
+package maze
+
+// Symmetry describes how a generated maze layout is reflected
+type Symmetry int
+
+const (
+    NoSymmetry         Symmetry = iota
+    MirrorSymmetry              // Left half mirrored onto the right half
+    RotationalSymmetry          // Left half rotated 180 degrees onto the right half
+)
+
+// String returns a display name for the symmetry option
+func (s Symmetry) String() string {
+    switch s {
+    case MirrorSymmetry:
+        return "Mirror"
+    case RotationalSymmetry:
+        return "Rotational"
+    default:
+        return "Off"
+    }
+}
+
+// Next cycles to the following symmetry option
+func (s Symmetry) Next() Symmetry {
+    return (s + 1) % 3
+}
+
+// Reflect returns the cell that mirrors p under this symmetry
+func (s Symmetry) Reflect(p Position, width, height int) Position {
+    switch s {
+    case MirrorSymmetry:
+        return Position{X: width - 1 - p.X, Y: p.Y}
+    case RotationalSymmetry:
+        return Position{X: width - 1 - p.X, Y: height - 1 - p.Y}
+    default:
+        return p
+    }
+}
+
+// IsSymmetric checks if the wall layout of the grid matches its reflection
+func (s *State) IsSymmetric(symmetry Symmetry) bool {
+    for y := 0; y < s.Height; y++ {
+        for x := 0; x < s.Width; x++ {
+            mirror := symmetry.Reflect(Position{X: x, Y: y}, s.Width, s.Height)
+            if s.Grid[y][x].IsWall() != s.Grid[mirror.Y][mirror.X].IsWall() {
+                return false
+            }
+        }
+    }
+    return true
+}
+
+// applySymmetry copies the carved left half onto the reflected cells
+func (g *Generator) applySymmetry(state *State) {
+    for y := 0; y < state.Height; y++ {
+        for x := 0; x < state.Width; x++ {
+            source := g.Symmetry.Reflect(Position{X: x, Y: y}, state.Width, state.Height)
+            
+            // Only overwrite cells in the reflected half. On an odd-width
+            // grid the center column reflects onto itself vertically
+            inReflectedHalf := x > (state.Width-1)/2 ||
+                (source.X == x && y > source.Y)
+            if inReflectedHalf {
+                state.Grid[y][x].Type = state.Grid[source.Y][source.X].Type
+            }
+        }
+    }
+}
+
+// connectSymmetric carves mirrored connectors until every walkable tile
+// can be reached from the start, so both halves form one maze
+func (g *Generator) connectSymmetric(state *State, start Position) {
+    for attempt := 0; attempt < state.Width*state.Height; attempt++ {
+        reachable := reachableCells(state, start)
+        
+        // Find a walkable tile cut off from the start
+        target, found := Position{}, false
+        for y := 0; y < state.Height && !found; y++ {
+            for x := 0; x < state.Width; x++ {
+                if !state.Grid[y][x].IsWall() && !reachable[y][x] {
+                    target, found = Position{X: x, Y: y}, true
+                    break
+                }
+            }
+        }
+        if !found {
+            return
+        }
+        
+        // Join it to the closest reachable tile
+        nearest, bestDistance := start, -1
+        for y := 0; y < state.Height; y++ {
+            for x := 0; x < state.Width; x++ {
+                distance := abs(x-target.X) + abs(y-target.Y)
+                if reachable[y][x] && (bestDistance < 0 || distance < bestDistance) {
+                    nearest, bestDistance = Position{X: x, Y: y}, distance
+                }
+            }
+        }
+        g.carveSymmetricLine(state, target, nearest)
+    }
+}
+
+// carveFloor turns the wall at p and at its reflection into floor, so
+// carving after the halves are mirrored keeps the layout symmetric
+func (g *Generator) carveFloor(state *State, p Position) {
+    for _, cell := range []Position{p, g.Symmetry.Reflect(p, state.Width, state.Height)} {
+        if state.Grid[cell.Y][cell.X].IsWall() {
+            state.SetTileType(cell.X, cell.Y, Floor)
+        }
+    }
+}
+
+// carveSymmetricLine carves an L-shaped corridor between two cells,
+// carving the reflection of every cell too so symmetry is kept
+func (g *Generator) carveSymmetricLine(state *State, from, to Position) {
+    carve := func(x, y int) {
+        g.carveFloor(state, Position{X: x, Y: y})
+    }
+    
+    x, y := from.X, from.Y
+    for x != to.X {
+        if x < to.X {
+            x++
+        } else {
+            x--
+        }
+        carve(x, y)
+    }
+    for y != to.Y {
+        if y < to.Y {
+            y++
+        } else {
+            y--
+        }
+        carve(x, y)
+    }
+}
+
+// reachableCells marks every walkable cell that can be reached from start
+func reachableCells(state *State, start Position) [][]bool {
+    reachable := make([][]bool, state.Height)
+    for y := range reachable {
+        reachable[y] = make([]bool, state.Width)
+    }
+    if state.GetTile(start.X, start.Y) == nil || state.Grid[start.Y][start.X].IsWall() {
+        return reachable
+    }
+    
+    // Directions: North, East, South, West
+    dx := []int{0, 1, 0, -1}
+    dy := []int{-1, 0, 1, 0}
+    
+    queue := []Position{start}
+    reachable[start.Y][start.X] = true
+    for len(queue) > 0 {
+        current := queue[0]
+        queue = queue[1:]
+        
+        for d := 0; d < 4; d++ {
+            nx, ny := current.X+dx[d], current.Y+dy[d]
+            tile := state.GetTile(nx, ny)
+            if tile != nil && !tile.IsWall() && !reachable[ny][nx] {
+                reachable[ny][nx] = true
+                queue = append(queue, Position{X: nx, Y: ny})
+            }
+        }
+    }
+    
+    return reachable
+}
@@ -0,0 +1,56 @@
+// internal/game/maze/symmetry_test.go
+package maze
+
+import "testing"
+
+func TestSymmetricMazesMirrorAndConnect(t *testing.T) {
+    sizes := [][2]int{{21, 21}, {20, 15}, {31, 17}}
+    for _, symmetry := range []Symmetry{MirrorSymmetry, RotationalSymmetry} {
+        for _, size := range sizes {
+            for seed := int64(1); seed <= 20; seed++ {
+                g := newTestGenerator(seed)
+                g.Symmetry = symmetry
+                g.TrapCount = 2
+                g.TeleporterPairs = 1
+                g.RoomCount = 2
+                state := g.Generate(size[0], size[1])
+                
+                if !state.IsSymmetric(symmetry) {
+                    t.Errorf("%v %dx%d seed %d: walls aren't symmetric", symmetry, size[0], size[1], seed)
+                }
+                
+                reachable := reachableCells(state, state.Start)
+                for y := 0; y < state.Height; y++ {
+                    for x := 0; x < state.Width; x++ {
+                        tile := state.Grid[y][x]
+                        if !tile.IsWall() && !reachable[y][x] {
+                            t.Errorf("%v %dx%d seed %d: (%d,%d) can't be reached from the start", symmetry, size[0], size[1], seed, x, y)
+                        }
+                        
+                        // Traps and teleporters come in reflected pairs
+                        mirror := symmetry.Reflect(Position{X: x, Y: y}, state.Width, state.Height)
+                        if tile.Type == Trap || tile.Type == Teleporter {
+                            if got := state.Grid[mirror.Y][mirror.X].Type; got != tile.Type {
+                                t.Errorf("%v %dx%d seed %d: %v at (%d,%d) reflects onto %v", symmetry, size[0], size[1], seed, tile.Type, x, y, got)
+                            }
+                        }
+                    }
+                }
+            }
+        }
+    }
+}
+
+func TestSymmetricCarvingOpensTheReflection(t *testing.T) {
+    g := newTestGenerator(1)
+    g.Symmetry = MirrorSymmetry
+    state := NewState(9, 5)
+    
+    g.carveFloor(state, Position{X: 2, Y: 2})
+    if state.Grid[2][2].IsWall() || state.Grid[2][6].IsWall() {
+        t.Error("carving (2,2) should open it and its mirror (6,2)")
+    }
+    if !state.IsSymmetric(MirrorSymmetry) {
+        t.Error("the grid should stay symmetric after carving")
+    }
+}
@@ -10,6 +10,7 @@ const (
     Goal
     SpecialTrigger // For tiles that trigger special events
     Trap           // For hazardous tiles
+    Teleporter     // Sends whoever arrives to its paired teleporter
     // Add more types as needed
 )
 
@@ -26,6 +27,8 @@ func (t TileType) String() string {
         return "SpecialTrigger"
     case Trap:
         return "Trap"
+    case Teleporter:
+        return "Teleporter"
     default:
         return "Unknown"
     }
@@ -38,7 +41,8 @@ type Tile struct {
     FlavorImage string
     X, Y        int
     Highlighted bool
-    Visited     bool // Used during maze generation
+    Visited     bool // Set once the player has explored this tile
+    VisitCount  int  // Number of times an entity has arrived on this tile
     
     // Additional properties can be added as needed
 }
@@ -71,6 +75,16 @@ func (t *Tile) IsFloor() bool {
     return t.Type == Floor
 }
 
+// IsTeleporter checks if this tile is a teleporter
+func (t *Tile) IsTeleporter() bool {
+    return t.Type == Teleporter
+}
+
+// RecordVisit counts an entity arriving on this tile
+func (t *Tile) RecordVisit() {
+    t.VisitCount++
+}
+
 // SetFlavorImage sets the flavor image for this tile
 func (t *Tile) SetFlavorImage(path string) {
     t.FlavorImage = path
@@ -0,0 +1,42 @@
+// internal/game/maze/timing.go
+package maze
+
+import (
+    "time"
+    
+    "github.com/JacobCromwell/Mazenasium/internal/game/logging"
+)
+
+// logger returns where generation timings are reported, the default
+// logger for generators built without one
+func (g *Generator) logger() logging.Logger {
+    if g.Logger == nil {
+        return logging.Default()
+    }
+    return g.Logger
+}
+
+// recordGenerateTime stores and logs how long a Generate call that began
+// at started took
+func (g *Generator) recordGenerateTime(width, height int, started time.Time) {
+    g.LastGenerateTime = time.Since(started)
+    g.logger().Debug("maze generated",
+        "width", width,
+        "height", height,
+        "seed", g.RandomSeed,
+        "elapsed", g.LastGenerateTime,
+    )
+}
+
+// Measure generates a maze on a copy of the generator and returns it with
+// the time generation took. The generator itself is left untouched, seed
+// included, so it can be called over and over to compare sizes and settings
+func (g *Generator) Measure(width, height int) (*State, time.Duration) {
+    trial := *g
+    trial.SpawnRequests = append([]Position(nil), g.SpawnRequests...)
+    trial.Rooms = nil
+    trial.Logger = logging.Nop{}
+    
+    state := trial.Generate(width, height)
+    return state, trial.LastGenerateTime
+}
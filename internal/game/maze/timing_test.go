@@ -0,0 +1,84 @@
+// internal/game/maze/timing_test.go
+package maze
+
+import (
+    "fmt"
+    "testing"
+)
+
+// generationAlgorithms are the generator setups benchmarked against each other
+var generationAlgorithms = []struct {
+    name  string
+    setup func(g *Generator)
+}{
+    {"perfect", func(g *Generator) { g.ExtraPathFactor = 0 }},
+    {"loops", func(g *Generator) { g.ExtraPathFactor = MaxExtraPathFactor }},
+    {"rooms", func(g *Generator) { g.RoomCount = 4 }},
+    {"mirror", func(g *Generator) { g.Symmetry = MirrorSymmetry }},
+    {"rotational", func(g *Generator) { g.Symmetry = RotationalSymmetry }},
+}
+
+func BenchmarkGenerate(b *testing.B) {
+    for _, size := range []int{21, 51, 101} {
+        for _, algorithm := range generationAlgorithms {
+            b.Run(fmt.Sprintf("%dx%d/%s", size, size, algorithm.name), func(b *testing.B) {
+                g := newTestGenerator(1)
+                algorithm.setup(g)
+                
+                var total float64
+                for i := 0; i < b.N; i++ {
+                    _, elapsed := g.Measure(size, size)
+                    total += float64(elapsed.Nanoseconds())
+                }
+                b.ReportMetric(total/float64(b.N), "measured-ns/op")
+            })
+        }
+    }
+}
+
+func TestMeasureLeavesTheGeneratorUntouched(t *testing.T) {
+    g := newTestGenerator(5)
+    g.RoomCount = 2
+    g.SpawnRequests = []Position{{X: 3, Y: 3}, {X: 9, Y: 9}}
+    g.Generate(31, 31)
+    seed, rooms := g.RandomSeed, append([]Room(nil), g.Rooms...)
+    requests := append([]Position(nil), g.SpawnRequests...)
+    lastTime := g.LastGenerateTime
+    
+    state, elapsed := g.Measure(51, 51)
+    if state == nil || state.Width != 51 || elapsed <= 0 {
+        t.Fatalf("Measure returned a %v state in %v", state != nil, elapsed)
+    }
+    
+    if g.RandomSeed != seed {
+        t.Errorf("RandomSeed = %d after Measure, want %d", g.RandomSeed, seed)
+    }
+    if len(g.Rooms) != len(rooms) {
+        t.Fatalf("%d rooms after Measure, want the %d from Generate", len(g.Rooms), len(rooms))
+    }
+    for i := range rooms {
+        if g.Rooms[i] != rooms[i] {
+            t.Errorf("room %d = %+v after Measure, want %+v", i, g.Rooms[i], rooms[i])
+        }
+    }
+    for i := range requests {
+        if g.SpawnRequests[i] != requests[i] {
+            t.Errorf("spawn request %d = %v after Measure, want %v", i, g.SpawnRequests[i], requests[i])
+        }
+    }
+    if g.LastGenerateTime != lastTime {
+        t.Errorf("LastGenerateTime changed from %v to %v", lastTime, g.LastGenerateTime)
+    }
+}
+
+func TestMeasureMatchesGenerate(t *testing.T) {
+    g := newTestGenerator(9)
+    measured, _ := g.Measure(25, 25)
+    generated := g.Generate(25, 25)
+    
+    for y := 0; y < generated.Height; y++ {
+        if !equalTypes(rowTypes(measured, y), rowTypes(generated, y)) {
+            t.Fatalf("row %d differs between Measure and Generate with the same seed", y)
+        }
+    }
+}
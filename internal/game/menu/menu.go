@@ -2,6 +2,8 @@
 package menu
 
 import (
+    "strings"
+
     "github.com/hajimehoshi/ebiten/v2"
     "github.com/hajimehoshi/ebiten/v2/inpututil"
 )
@@ -11,16 +13,60 @@ type ItemType int
 const (
     ButtonItem ItemType = iota
     SubmenuItem
+    InputItem // Numeric text field, Enter submits the typed value
 )
 
 type Item struct {
-    Text     string
-    Type     ItemType
-    Selected bool
-    Action   string
-    Submenu  *Menu
+    Text      string
+    Type      ItemType
+    Selected  bool
+    Action    string
+    Submenu   *Menu
+    Value     string // Typed text for input items
+    MaxLength int    // Longest value an input item accepts
+    EmptyText string // Shown in place of an empty value
+    Hidden    bool   // Left out of the menu until there is something to offer
+    
+    // Key that jumps straight to the item and chooses it, when HasAccelerator is set
+    Accelerator    ebiten.Key
+    HasAccelerator bool
+}
+
+// reservedKeys drive menu navigation and text entry, so they never act as accelerators
+var reservedKeys = map[ebiten.Key]bool{
+    ebiten.KeyUp:        true,
+    ebiten.KeyDown:      true,
+    ebiten.KeyEnter:     true,
+    ebiten.KeyEscape:    true,
+    ebiten.KeyBackspace: true,
+    ebiten.Key0:         true,
+    ebiten.Key1:         true,
+    ebiten.Key2:         true,
+    ebiten.Key3:         true,
+    ebiten.Key4:         true,
+    ebiten.Key5:         true,
+    ebiten.Key6:         true,
+    ebiten.Key7:         true,
+    ebiten.Key8:         true,
+    ebiten.Key9:         true,
+}
+
+// IsReservedKey checks if a key is used for navigation or typing and can't be an accelerator
+func IsReservedKey(key ebiten.Key) bool {
+    return reservedKeys[key]
 }
 
+// AcceleratorKey returns the item's accelerator, if it has a usable one
+func (i *Item) AcceleratorKey() (ebiten.Key, bool) {
+    if !i.HasAccelerator || IsReservedKey(i.Accelerator) {
+        return 0, false
+    }
+    return i.Accelerator, true
+}
+
+// SeedMaxLength keeps typed seeds within the range of an int64
+const SeedMaxLength = 18
+
 type Menu struct {
     Title    string
     Items    []Item
@@ -39,9 +85,22 @@ func NewManager() *Manager {
     rootMenu := &Menu{
         Title: "Mazenasium",
         Items: []Item{
-            {Text: "Start Game", Type: ButtonItem, Selected: true, Action: "start_game"},
-            {Text: "Customize", Type: SubmenuItem},
-            {Text: "Quit", Type: ButtonItem, Action: "quit"},
+            {Text: "Start Game", Type: ButtonItem, Selected: true, Action: "start_game", Accelerator: ebiten.KeyS, HasAccelerator: true},
+            {Text: "Continue", Type: ButtonItem, Action: "continue_game", Hidden: true},
+            {Text: "Watch NPCs", Type: ButtonItem, Action: "start_sandbox", Accelerator: ebiten.KeyW, HasAccelerator: true},
+            {Text: "Practice", Type: ButtonItem, Action: "start_practice", Accelerator: ebiten.KeyP, HasAccelerator: true},
+            {Text: "Customize", Type: SubmenuItem, Accelerator: ebiten.KeyC, HasAccelerator: true},
+            {Text: "Quit", Type: SubmenuItem, Accelerator: ebiten.KeyQ, HasAccelerator: true},
+        },
+        Selected: 0,
+    }
+    
+    // Create quit confirmation submenu - "No" is selected so a stray Enter is harmless
+    quitMenu := &Menu{
+        Title: "Really quit?",
+        Items: []Item{
+            {Text: "No", Type: ButtonItem, Selected: true, Action: "back", Accelerator: ebiten.KeyN, HasAccelerator: true},
+            {Text: "Yes", Type: ButtonItem, Action: "quit", Accelerator: ebiten.KeyY, HasAccelerator: true},
         },
         Selected: 0,
     }
@@ -50,16 +109,53 @@ func NewManager() *Manager {
     customizeMenu := &Menu{
         Title: "Customize",
         Items: []Item{
-            {Text: "Option 1", Type: ButtonItem, Selected: true, Action: "option1"},
-            {Text: "Option 2", Type: ButtonItem, Action: "option2"},
-            {Text: "Back", Type: ButtonItem, Action: "back"},
+            {Text: "Screen Shake: On", Type: ButtonItem, Selected: true, Action: "toggle_shake"},
+            {Text: "Goal Pulse: On", Type: ButtonItem, Action: "toggle_goal_pulse"},
+            {Text: "High Contrast: Off", Type: ButtonItem, Action: "toggle_contrast"},
+            {Text: "Debug Keys: Off", Type: ButtonItem, Action: "toggle_debug"},
+            {Text: "Explore Hint: Off", Type: ButtonItem, Action: "toggle_explore_hint"},
+            {Text: "Fit Maze: On", Type: ButtonItem, Action: "toggle_fit_maze"},
+            {Text: "Movement: Linear", Type: ButtonItem, Action: "cycle_easing"},
+            {Text: "Auto-Save: Off", Type: ButtonItem, Action: "toggle_autosave"},
+            {Text: "Instant Moves: Off", Type: ButtonItem, Action: "toggle_instant_moves"},
+            {Text: "Walls: Solid", Type: ButtonItem, Action: "cycle_wall_style"},
+            {Text: "Lighting: Off", Type: ButtonItem, Action: "toggle_lighting"},
+            {Text: "Light Radius: 5", Type: ButtonItem, Action: "cycle_light_radius"},
+            {Text: "Symmetry: Off", Type: ButtonItem, Action: "cycle_symmetry"},
+            {Text: "Actions: Cooldown", Type: ButtonItem, Action: "cycle_action_mode"},
+            {Text: "Action Popup: Center", Type: ButtonItem, Action: "cycle_popup_anchor"},
+            {Text: "Trivia: Off", Type: ButtonItem, Action: "cycle_trivia"},
+            {Text: "Shuffle Answers: Off", Type: ButtonItem, Action: "toggle_trivia_shuffle"},
+            {Text: "Wrong Answer: None", Type: ButtonItem, Action: "cycle_wrong_answer"},
+            {Text: "Player Moves: 1", Type: ButtonItem, Action: "cycle_player_moves"},
+            {Text: "NPC Moves: 1", Type: ButtonItem, Action: "cycle_npc_moves"},
+            {Text: "NPC Delay: Off", Type: ButtonItem, Action: "cycle_npc_delay"},
+            {Text: "NPC Randomness: 0%", Type: ButtonItem, Action: "cycle_npc_wander"},
+            {Text: "NPC Rotations: Off", Type: ButtonItem, Action: "cycle_npc_rotate"},
+            {Text: "NPC Patterns: Orthogonal", Type: ButtonItem, Action: "toggle_npc_patterns"},
+            {Text: "NPC Chase: Off", Type: ButtonItem, Action: "toggle_chase"},
+            {Text: "Co-op: Off", Type: ButtonItem, Action: "toggle_coop"},
+            {Text: "Win: Race to Goal", Type: ButtonItem, Action: "cycle_win_mode"},
+            {Text: "Survive: 20 Turns", Type: ButtonItem, Action: "cycle_survival_turns"},
+            {Text: "Target: 5 Points", Type: ButtonItem, Action: "cycle_target_score"},
+            {Text: "Loops: 1.0", Type: ButtonItem, Action: "cycle_loops"},
+            {Text: "Rooms: 0", Type: ButtonItem, Action: "cycle_rooms"},
+            {Text: "Room Size: 5", Type: ButtonItem, Action: "cycle_room_size"},
+            {Text: "Start: Top Left", Type: ButtonItem, Action: "cycle_start_corner"},
+            {Text: "Goal: Opposite", Type: ButtonItem, Action: "cycle_goal_corner"},
+            {Text: "Shrinking Maze: Off", Type: ButtonItem, Action: "cycle_shrink"},
+            {Text: "Seed", Type: InputItem, Action: "set_seed", MaxLength: SeedMaxLength, EmptyText: "Random"},
+            {Text: "Reroll Maze", Type: ButtonItem, Action: "reroll_maze"},
+            {Text: "Back", Type: ButtonItem, Action: "back", Accelerator: ebiten.KeyB, HasAccelerator: true},
         },
         Selected: 0,
     }
     
     // Link menus
-    rootMenu.Items[1].Submenu = customizeMenu
+    rootMenu.Items[4].Submenu = customizeMenu
     customizeMenu.Parent = rootMenu
+    rootMenu.Items[5].Submenu = quitMenu
+    quitMenu.Parent = rootMenu
     
     return &Manager{
         CurrentMenu: rootMenu,
@@ -76,10 +172,13 @@ func (m *Manager) MoveSelectionUp() {
     // Deselect current item
     m.CurrentMenu.Items[m.CurrentMenu.Selected].Selected = false
     
-    // Move selection up
-    m.CurrentMenu.Selected--
-    if m.CurrentMenu.Selected < 0 {
-        m.CurrentMenu.Selected = len(m.CurrentMenu.Items) - 1
+    // Move selection up, passing over hidden items
+    count := len(m.CurrentMenu.Items)
+    for step := 0; step < count; step++ {
+        m.CurrentMenu.Selected = (m.CurrentMenu.Selected - 1 + count) % count
+        if !m.CurrentMenu.Items[m.CurrentMenu.Selected].Hidden {
+            break
+        }
     }
     
     // Select new item
@@ -95,8 +194,14 @@ func (m *Manager) MoveSelectionDown() {
     // Deselect current item
     m.CurrentMenu.Items[m.CurrentMenu.Selected].Selected = false
     
-    // Move selection down
-    m.CurrentMenu.Selected = (m.CurrentMenu.Selected + 1) % len(m.CurrentMenu.Items)
+    // Move selection down, passing over hidden items
+    count := len(m.CurrentMenu.Items)
+    for step := 0; step < count; step++ {
+        m.CurrentMenu.Selected = (m.CurrentMenu.Selected + 1) % count
+        if !m.CurrentMenu.Items[m.CurrentMenu.Selected].Hidden {
+            break
+        }
+    }
     
     // Select new item
     m.CurrentMenu.Items[m.CurrentMenu.Selected].Selected = true
@@ -125,8 +230,89 @@ func (m *Manager) SelectCurrentItem() string {
     }
 }
 
+// Label returns the text to display for the item
+func (i *Item) Label() string {
+    if i.Type != InputItem {
+        return i.markAccelerator(i.Text)
+    }
+    
+    value := i.Value
+    if value == "" {
+        value = i.EmptyText
+    }
+    return i.markAccelerator(i.Text) + ": " + value
+}
+
+// markAccelerator brackets the first letter of text matching the item's
+// accelerator, or appends the key in brackets when no letter matches
+func (i *Item) markAccelerator(text string) string {
+    key, ok := i.AcceleratorKey()
+    if !ok {
+        return text
+    }
+    
+    name := key.String()
+    if index := strings.Index(strings.ToUpper(text), strings.ToUpper(name)); index >= 0 {
+        return text[:index] + "[" + text[index:index+len(name)] + "]" + text[index+len(name):]
+    }
+    return text + " [" + name + "]"
+}
+
+// Accelerate selects the item in the current menu whose accelerator is key
+// and chooses it, as if it had been selected and Enter pressed.
+// Returns the chosen action and whether any item uses the key
+func (m *Manager) Accelerate(key ebiten.Key) (string, bool) {
+    if m.CurrentMenu == nil {
+        return "", false
+    }
+    
+    for index := range m.CurrentMenu.Items {
+        itemKey, ok := m.CurrentMenu.Items[index].AcceleratorKey()
+        if !ok || itemKey != key || m.CurrentMenu.Items[index].Hidden {
+            continue
+        }
+        
+        m.CurrentMenu.Items[m.CurrentMenu.Selected].Selected = false
+        m.CurrentMenu.Selected = index
+        m.CurrentMenu.Items[index].Selected = true
+        return m.SelectCurrentItem(), true
+    }
+    return "", false
+}
+
+// AppendInput adds the typed digits to an input item's value,
+// ignoring anything else and stopping at MaxLength
+func (i *Item) AppendInput(chars []rune) {
+    for _, c := range chars {
+        if c < '0' || c > '9' {
+            continue
+        }
+        if i.MaxLength > 0 && len(i.Value) >= i.MaxLength {
+            return
+        }
+        i.Value += string(c)
+    }
+}
+
+// Backspace removes the last character of an input item's value
+func (i *Item) Backspace() {
+    if len(i.Value) > 0 {
+        i.Value = i.Value[:len(i.Value)-1]
+    }
+}
+
 // HandleInput processes keyboard input for menu navigation
 func (m *Manager) HandleInput() string {
+    // Typing goes to the selected input field
+    if m.CurrentMenu != nil && len(m.CurrentMenu.Items) > 0 {
+        if item := &m.CurrentMenu.Items[m.CurrentMenu.Selected]; item.Type == InputItem {
+            item.AppendInput(ebiten.AppendInputChars(nil))
+            if inpututil.IsKeyJustPressed(ebiten.KeyBackspace) {
+                item.Backspace()
+            }
+        }
+    }
+    
     if inpututil.IsKeyJustPressed(ebiten.KeyUp) {
         m.MoveSelectionUp()
     }
@@ -139,5 +325,106 @@ func (m *Manager) HandleInput() string {
         return m.SelectCurrentItem()
     }
     
+    // Jump straight to an item by its accelerator
+    if m.CurrentMenu != nil {
+        for _, item := range m.CurrentMenu.Items {
+            if key, ok := item.AcceleratorKey(); ok && inpututil.IsKeyJustPressed(key) {
+                action, _ := m.Accelerate(key)
+                return action
+            }
+        }
+    }
+    
     return ""
-}
\ No newline at end of file
+}
+
+// SetItemText changes the label of every item with the given action,
+// searching the root menu and all of its submenus
+func (m *Manager) SetItemText(action, text string) {
+    setItemText(m.RootMenu, action, text)
+}
+
+// setItemText recursively relabels matching items in a menu tree
+func setItemText(menu *Menu, action, text string) {
+    if menu == nil {
+        return
+    }
+    
+    for i := range menu.Items {
+        if menu.Items[i].Action == action {
+            menu.Items[i].Text = text
+        }
+        setItemText(menu.Items[i].Submenu, action, text)
+    }
+}
+
+// SetItemHidden shows or hides every item with the given action,
+// searching the root menu and all of its submenus
+func (m *Manager) SetItemHidden(action string, hidden bool) {
+    setItemHidden(m.RootMenu, action, hidden)
+}
+
+// setItemHidden recursively shows or hides matching items in a menu tree
+func setItemHidden(menu *Menu, action string, hidden bool) {
+    if menu == nil {
+        return
+    }
+    
+    for i := range menu.Items {
+        if menu.Items[i].Action == action {
+            menu.Items[i].Hidden = hidden
+        }
+        setItemHidden(menu.Items[i].Submenu, action, hidden)
+    }
+}
+
+// ItemValue returns the typed value of the first item with the given action
+func (m *Manager) ItemValue(action string) string {
+    if item := findItem(m.RootMenu, action); item != nil {
+        return item.Value
+    }
+    return ""
+}
+
+// SetItemValue replaces the typed value of the first item with the given action
+func (m *Manager) SetItemValue(action, value string) {
+    if item := findItem(m.RootMenu, action); item != nil {
+        item.Value = value
+    }
+}
+
+// findItem recursively searches a menu tree for an item with the given action
+func findItem(menu *Menu, action string) *Item {
+    if menu == nil {
+        return nil
+    }
+    
+    for i := range menu.Items {
+        if menu.Items[i].Action == action {
+            return &menu.Items[i]
+        }
+        if item := findItem(menu.Items[i].Submenu, action); item != nil {
+            return item
+        }
+    }
+    return nil
+}
+
+// OpenSubmenu navigates to the root submenu with the given title and
+// selects the item at index selected
+func (m *Manager) OpenSubmenu(title string, selected int) {
+    for _, item := range m.RootMenu.Items {
+        if item.Submenu == nil || item.Submenu.Title != title {
+            continue
+        }
+        
+        submenu := item.Submenu
+        if selected >= 0 && selected < len(submenu.Items) {
+            submenu.Items[submenu.Selected].Selected = false
+            submenu.Selected = selected
+            submenu.Items[selected].Selected = true
+        }
+        m.CurrentMenu = submenu
+        return
+    }
+}
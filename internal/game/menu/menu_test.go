@@ -0,0 +1,119 @@
+// internal/game/menu/menu_test.go
+package menu
+
+import (
+    "testing"
+
+    "github.com/hajimehoshi/ebiten/v2"
+)
+
+// selectItem moves the selection in the current menu to the item with the given text
+func selectItem(t *testing.T, m *Manager, text string) {
+    t.Helper()
+    for index, item := range m.CurrentMenu.Items {
+        if item.Text == text {
+            m.CurrentMenu.Items[m.CurrentMenu.Selected].Selected = false
+            m.CurrentMenu.Selected = index
+            m.CurrentMenu.Items[index].Selected = true
+            return
+        }
+    }
+    t.Fatalf("no %q item in the %q menu", text, m.CurrentMenu.Title)
+}
+
+func TestQuitThenNoReturnsToRoot(t *testing.T) {
+    m := NewManager()
+
+    selectItem(t, m, "Quit")
+    if action := m.SelectCurrentItem(); action != "" {
+        t.Fatalf("opening the quit confirmation returned %q", action)
+    }
+    if m.CurrentMenu.Title != "Really quit?" {
+        t.Fatalf("current menu is %q, want the quit confirmation", m.CurrentMenu.Title)
+    }
+
+    // No is selected first, so a stray Enter never quits
+    if action := m.SelectCurrentItem(); action != "" {
+        t.Errorf("choosing No returned %q, want no action", action)
+    }
+    if m.CurrentMenu != m.RootMenu {
+        t.Errorf("current menu is %q after No, want the root menu", m.CurrentMenu.Title)
+    }
+}
+
+func TestQuitThenYesQuits(t *testing.T) {
+    m := NewManager()
+
+    selectItem(t, m, "Quit")
+    m.SelectCurrentItem()
+    selectItem(t, m, "Yes")
+
+    if action := m.SelectCurrentItem(); action != "quit" {
+        t.Errorf("choosing Yes returned %q, want %q", action, "quit")
+    }
+}
+
+func TestQuitAcceleratorsConfirm(t *testing.T) {
+    m := NewManager()
+
+    if _, ok := m.Accelerate(ebiten.KeyQ); !ok || m.CurrentMenu.Title != "Really quit?" {
+        t.Fatal("Q should open the quit confirmation")
+    }
+    if action, _ := m.Accelerate(ebiten.KeyN); action != "" || m.CurrentMenu != m.RootMenu {
+        t.Errorf("N returned %q in the %q menu, want the root menu", action, m.CurrentMenu.Title)
+    }
+}
+
+func TestSeedInputAccumulatesDigits(t *testing.T) {
+    item := &Item{Type: InputItem, Action: "set_seed", MaxLength: 4}
+
+    item.AppendInput([]rune("1a2"))
+    if item.Value != "12" {
+        t.Fatalf("Value = %q after typing 1a2, want only the digits %q", item.Value, "12")
+    }
+
+    item.AppendInput([]rune("345"))
+    if item.Value != "1234" {
+        t.Fatalf("Value = %q, want it stopped at MaxLength as %q", item.Value, "1234")
+    }
+
+    item.Backspace()
+    if item.Value != "123" {
+        t.Errorf("Value = %q after backspace, want %q", item.Value, "123")
+    }
+    for i := 0; i < 4; i++ {
+        item.Backspace()
+    }
+    if item.Value != "" {
+        t.Errorf("Value = %q after clearing, want it empty", item.Value)
+    }
+}
+
+func TestAcceleratorIgnoresTheSelection(t *testing.T) {
+    for _, selected := range []string{"Start Game", "Practice", "Quit"} {
+        m := NewManager()
+        selectItem(t, m, selected)
+        
+        action, ok := m.Accelerate(ebiten.KeyW)
+        if !ok || action != "start_sandbox" {
+            t.Errorf("W with %q selected returned %q, %v, want %q", selected, action, ok, "start_sandbox")
+        }
+        if m.CurrentMenu.Items[m.CurrentMenu.Selected].Text != "Watch NPCs" {
+            t.Errorf("W with %q selected left the selection on %q", selected, m.CurrentMenu.Items[m.CurrentMenu.Selected].Text)
+        }
+    }
+}
+
+func TestUnusedAndReservedKeysDoNothing(t *testing.T) {
+    m := NewManager()
+    m.RootMenu.Items[0].Accelerator = ebiten.KeyEnter
+    
+    for _, key := range []ebiten.Key{ebiten.KeyZ, ebiten.KeyEnter} {
+        if action, ok := m.Accelerate(key); ok || action != "" {
+            t.Errorf("%v returned %q, %v, want no item", key, action, ok)
+        }
+    }
+    if m.CurrentMenu.Selected != 0 {
+        t.Errorf("selection moved to %d", m.CurrentMenu.Selected)
+    }
+}
@@ -0,0 +1,53 @@
+// internal/game/motion/motion_test.go
+package motion
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEaseInOutFixesTheEnds(t *testing.T) {
+	for _, easing := range []Easing{Linear, EaseInOut} {
+		if got := easing.Apply(0); got != 0 {
+			t.Errorf("%v: Apply(0) = %v, want 0", easing, got)
+		}
+		if got := easing.Apply(1); got != 1 {
+			t.Errorf("%v: Apply(1) = %v, want 1", easing, got)
+		}
+		if got := easing.Apply(1.5); got != 1 {
+			t.Errorf("%v: Apply(1.5) = %v, want it clamped to 1", easing, got)
+		}
+	}
+}
+
+func TestEaseInOutVelocityIsNotConstant(t *testing.T) {
+	const samples = 10
+	velocities := make([]float64, samples)
+	for i := range velocities {
+		from, to := float64(i)/samples, float64(i+1)/samples
+		velocities[i] = EaseInOut.Apply(to) - EaseInOut.Apply(from)
+	}
+
+	if velocities[0] >= velocities[samples/2] || velocities[samples-1] >= velocities[samples/2] {
+		t.Errorf("velocities %v, want slow at both ends and fastest in the middle", velocities)
+	}
+	for i := 1; i < samples; i++ {
+		if math.Abs(Linear.Apply(float64(i)/samples)-float64(i)/samples) > 1e-9 {
+			t.Errorf("linear easing moved off the line at %v", float64(i)/samples)
+		}
+	}
+}
+
+func TestAdvanceIsFrameRateIndependent(t *testing.T) {
+	coarse := Advance(0, 40, 300, 0.1)
+	fine := 0.0
+	for i := 0; i < 10; i++ {
+		fine = Advance(fine, 40, 300, 0.01)
+	}
+	if math.Abs(coarse-fine) > 1e-9 {
+		t.Errorf("one 0.1s step reached %v, ten 0.01s steps %v", coarse, fine)
+	}
+	if got := Advance(0.9, 40, 300, 1); got != 1 {
+		t.Errorf("Advance overshot to %v, want it stopped at 1", got)
+	}
+}
@@ -0,0 +1,37 @@
+// internal/game/npc/color.go
+package npc
+
+import (
+	"image/color"
+)
+
+// DefaultPalette is the curated set of NPC colors, picked to stand apart
+// from each other and from the blue player
+var DefaultPalette = []color.RGBA{
+	{255, 0, 0, 255},     // Red
+	{0, 255, 0, 255},     // Green
+	{255, 165, 0, 255},   // Orange
+	{0, 200, 255, 255},   // Cyan
+	{255, 255, 0, 255},   // Yellow
+	{255, 105, 180, 255}, // Pink
+	{160, 82, 45, 255},   // Brown
+	{255, 255, 255, 255}, // White
+}
+
+// PaletteColor returns the color for the NPC with the given index, cycling
+// through the palette. An empty palette falls back to DefaultPalette
+func PaletteColor(palette []color.RGBA, index int) color.RGBA {
+	if len(palette) == 0 {
+		palette = DefaultPalette
+	}
+	index %= len(palette)
+	if index < 0 {
+		index += len(palette)
+	}
+	return palette[index]
+}
+
+// DefaultColor returns the DefaultPalette color for the NPC with the given index
+func DefaultColor(index int) color.RGBA {
+	return PaletteColor(DefaultPalette, index)
+}
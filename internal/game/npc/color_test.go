@@ -0,0 +1,31 @@
+// internal/game/npc/color_test.go
+package npc
+
+import (
+	"image/color"
+	"testing"
+)
+
+func TestFirstPaletteColorsAreDistinct(t *testing.T) {
+	seen := map[color.RGBA]int{}
+	for i := 0; i < len(DefaultPalette); i++ {
+		c := DefaultColor(i)
+		if previous, ok := seen[c]; ok {
+			t.Errorf("NPCs %d and %d share the color %v", previous, i, c)
+		}
+		seen[c] = i
+	}
+}
+
+func TestPaletteColorCyclesAndFallsBack(t *testing.T) {
+	palette := []color.RGBA{{1, 2, 3, 255}, {4, 5, 6, 255}}
+	if got := PaletteColor(palette, 2); got != palette[0] {
+		t.Errorf("index 2 of a two-color palette = %v, want it to wrap to %v", got, palette[0])
+	}
+	if got := PaletteColor(palette, -1); got != palette[1] {
+		t.Errorf("index -1 = %v, want the last color %v", got, palette[1])
+	}
+	if got := PaletteColor(nil, 3); got != DefaultPalette[3] {
+		t.Errorf("an empty palette gave %v, want the default %v", got, DefaultPalette[3])
+	}
+}
@@ -0,0 +1,51 @@
+// internal/game/npc/mix.go
+package npc
+
+// StrategyWeight is one strategy in an NPC's behavior mix and how often it
+// is picked relative to the others
+type StrategyWeight struct {
+	Strategy Strategy
+	Weight   float64
+}
+
+// Mixed returns a behavior mix that follows primary but wanders for a turn
+// with the given chance (0-1), so the NPC doesn't play perfectly
+func Mixed(primary Strategy, wanderChance float64) []StrategyWeight {
+	if wanderChance <= 0 || primary == Wander {
+		return nil
+	}
+	if wanderChance > 1 {
+		wanderChance = 1
+	}
+	return []StrategyWeight{
+		{Strategy: primary, Weight: 1 - wanderChance},
+		{Strategy: Wander, Weight: wanderChance},
+	}
+}
+
+// pickStrategy chooses the strategy an NPC follows this turn from its mix,
+// using roll (0-1) from the manager's shared random source.
+// An NPC whose weights are all zero keeps its Strategy
+func (n *NPC) pickStrategy(roll float64) Strategy {
+	total := 0.0
+	for _, entry := range n.Mix {
+		if entry.Weight > 0 {
+			total += entry.Weight
+		}
+	}
+	if total <= 0 {
+		return n.Strategy
+	}
+
+	target := roll * total
+	for _, entry := range n.Mix {
+		if entry.Weight <= 0 {
+			continue
+		}
+		if target < entry.Weight {
+			return entry.Strategy
+		}
+		target -= entry.Weight
+	}
+	return n.Mix[len(n.Mix)-1].Strategy
+}
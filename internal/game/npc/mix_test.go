@@ -0,0 +1,61 @@
+// internal/game/npc/mix_test.go
+package npc
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMixFollowsTheConfiguredWeights(t *testing.T) {
+	mazeObj := buildMaze(
+		"#######",
+		"#.....#",
+		"#.....#",
+		"#....G#",
+		"#######",
+	)
+	for _, wander := range []float64{0.2, 0.5, 0.8} {
+		m := NewManagerWithSeed(11)
+		n := newTestNPC(0, 1, 1)
+		n.Strategy = Chase
+		n.Mix = Mixed(Chase, wander)
+		m.AddNPC(n)
+
+		const turns = 4000
+		wandered := 0
+		for turn := 0; turn < turns; turn++ {
+			m.ResetMovedStatus()
+			runPhase(m, mazeObj, mazeObj.StartPosition(), mazeObj.IsValidMove)
+			if n.TurnStrategy == Wander {
+				wandered++
+			}
+		}
+
+		if got := float64(wandered) / turns; math.Abs(got-wander) > 0.03 {
+			t.Errorf("wander chance %v: wandered on %.3f of turns", wander, got)
+		}
+	}
+}
+
+func TestPickStrategyIgnoresZeroWeights(t *testing.T) {
+	n := &NPC{Strategy: Optimal, Mix: []StrategyWeight{{Strategy: Wander, Weight: 0}, {Strategy: Chase, Weight: 1}}}
+	for _, roll := range []float64{0, 0.5, 0.999} {
+		if got := n.pickStrategy(roll); got != Chase {
+			t.Errorf("roll %v picked %v, want the only weighted strategy", roll, got)
+		}
+	}
+
+	n.Mix = []StrategyWeight{{Strategy: Wander, Weight: 0}}
+	if got := n.pickStrategy(0.5); got != Optimal {
+		t.Errorf("an all-zero mix picked %v, want the NPC's own strategy", got)
+	}
+}
+
+func TestMixedSkipsWanderers(t *testing.T) {
+	if mix := Mixed(Wander, 0.5); mix != nil {
+		t.Errorf("Mixed(Wander) = %v, want no mix", mix)
+	}
+	if mix := Mixed(Chase, 0); mix != nil {
+		t.Errorf("Mixed with no wander chance = %v, want no mix", mix)
+	}
+}
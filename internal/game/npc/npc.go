@@ -2,8 +2,10 @@ package npc
 
 import (
 	"image/color"
-	"math"
 	"math/rand"
+
+	"github.com/JacobCromwell/Mazenasium/internal/game/maze"
+	"github.com/JacobCromwell/Mazenasium/internal/game/motion"
 )
 
 // NPC represents a non-player character
@@ -12,26 +14,63 @@ type NPC struct {
 	GridX, GridY int
 	X, Y         float64 // Actual position for smooth movement
 	DestX, DestY float64 // Destination for smooth movement
+	StartX       float64       // Where the current slide began
+	StartY       float64
+	Progress     float64       // How far along the current slide, 0.0 to 1.0
+	Easing       motion.Easing // Curve the slide follows
+	Instant      bool          // Skip the slide and snap straight onto the destination
 	Moving       bool
-	Size         float64
+	Size         float64 // Drawn size in pixels
+	TileSize     float64 // Size of a grid cell in pixels
 	Color        color.RGBA
+	Shape        Shape   // How the NPC is drawn
 	HasMoved     bool    // Track if NPC has moved in current turn
+	Pattern      MovementPattern // Which moves this NPC can make
+	MovesPerTurn int             // Steps the NPC takes each turn, values below 1 count as 1
+	Strategy     Strategy        // How the NPC picks its moves
+	Mix          []StrategyWeight // Chance of each strategy being used for a turn, empty always uses Strategy
+	TurnStrategy Strategy         // Strategy picked for the current turn
+	Rand         *rand.Rand      // The NPC's own random stream, the global source if nil
+	movesMade    int             // Steps taken so far this turn
 }
 
-// New creates a new NPC instance
-func New(id, gridX, gridY int, size float64, color color.RGBA) *NPC {
+// MaxMovesPerTurn is the most steps any NPC may take in one turn
+const MaxMovesPerTurn = 3
+
+// Shape is the outline an NPC is drawn with, so NPCs differ beyond color
+type Shape int
+
+const (
+	Square Shape = iota
+	Circle
+	Triangle
+)
+
+// Strategy decides how an NPC chooses where to go
+type Strategy int
+
+const (
+	Wander  Strategy = iota // Random moves from the NPC's movement pattern
+	Optimal                 // Follows the shortest path to the goal
+	Chase                   // Follows the shortest path to the player
+)
+
+// New creates a new NPC instance sized to fill a tile of the given size
+func New(id, gridX, gridY int, tileSize float64, color color.RGBA) *NPC {
 	npc := &NPC{
-		ID:       id,
-		GridX:    gridX,
-		GridY:    gridY,
-		Size:     size,
-		Color:    color,
-		HasMoved: false,
+		ID:           id,
+		GridX:        gridX,
+		GridY:        gridY,
+		Size:         tileSize,
+		TileSize:     tileSize,
+		Color:        color,
+		HasMoved:     false,
+		MovesPerTurn: 1,
 	}
 	
 	// Set initial position
-	npc.X = float64(gridX) * size
-	npc.Y = float64(gridY) * size
+	npc.X = float64(gridX) * tileSize
+	npc.Y = float64(gridY) * tileSize
 	npc.DestX = npc.X
 	npc.DestY = npc.Y
 	
@@ -46,37 +85,46 @@ func (n *NPC) IsMoving() bool {
 // ResetMovedStatus resets the HasMoved flag
 func (n *NPC) ResetMovedStatus() {
 	n.HasMoved = false
+	n.movesMade = 0
+}
+
+// Teleport places the NPC on a cell instantly, without smooth movement
+func (n *NPC) Teleport(gridX, gridY int) {
+	n.GridX = gridX
+	n.GridY = gridY
+	n.X = float64(gridX) * n.TileSize
+	n.Y = float64(gridY) * n.TileSize
+	n.DestX = n.X
+	n.DestY = n.Y
+	n.Moving = false
 }
 
 // UpdatePosition updates the NPC's position with smooth movement
+// speed is in pixels per second and dt the seconds since the last update
 // Returns true if the NPC has reached the destination
-func (n *NPC) UpdatePosition(moveSpeed float64) bool {
+func (n *NPC) UpdatePosition(speed, dt float64) bool {
 	if !n.Moving {
 		return false
 	}
-	
-	dx := n.DestX - n.X
-	dy := n.DestY - n.Y
-	
-	if math.Abs(dx) < moveSpeed && math.Abs(dy) < moveSpeed {
+
+	distance := motion.Distance(n.StartX, n.StartY, n.DestX, n.DestY)
+	n.Progress = motion.Advance(n.Progress, distance, speed, dt)
+	if n.Progress >= 1 {
 		// Arrived at destination
 		n.X = n.DestX
 		n.Y = n.DestY
 		n.Moving = false
 		return true
-	} else {
-		// Move toward destination
-		if dx != 0 {
-			n.X += math.Copysign(moveSpeed, dx)
-		}
-		if dy != 0 {
-			n.Y += math.Copysign(moveSpeed, dy)
-		}
-		return false
 	}
+
+	// Place the NPC along the eased curve between start and destination
+	eased := n.Easing.Apply(n.Progress)
+	n.X = n.StartX + (n.DestX-n.StartX)*eased
+	n.Y = n.StartY + (n.DestY-n.StartY)*eased
+	return false
 }
 
-// TryMove attempts to move the NPC in a valid direction
+// TryMove attempts to move the NPC in a valid direction for its pattern
 // validMoveFn is a callback that determines if a move is valid
 // Returns true if successfully moved
 func (n *NPC) TryMove(validMoveFn func(x, y int) bool) bool {
@@ -84,58 +132,124 @@ func (n *NPC) TryMove(validMoveFn func(x, y int) bool) bool {
 		return false // Already moving or has moved this turn
 	}
 
-	// Possible movement directions: left, right, up, down
-	directions := []struct{ dx, dy int }{
-		{-1, 0}, {1, 0}, {0, -1}, {0, 1},
+	// Take the first valid move the pattern offers
+	candidates := n.Pattern.CandidateMoves(n.GridX, n.GridY, validMoveFn, n.Rand)
+	if len(candidates) > 0 {
+		n.moveTo(n.GridX+candidates[0].DX, n.GridY+candidates[0].DY)
+		return true
+	}
+
+	// If NPC can't move in any direction, mark as moved anyway
+	n.HasMoved = true
+	return false
+}
+
+// TryMoveToGoal steps the NPC along the shortest path to the goal
+// Falls back to TryMove if the next step is blocked
+func (n *NPC) TryMoveToGoal(mazeObj *maze.Maze, validMoveFn func(x, y int) bool) bool {
+	if n.Moving || n.HasMoved {
+		return false
+	}
+
+	if step, ok := mazeObj.NextStepToGoal(n.GridX, n.GridY); ok && validMoveFn(step.X, step.Y) {
+		n.moveTo(step.X, step.Y)
+		return true
+	}
+	return n.TryMove(validMoveFn)
+}
+
+// TryMoveToward steps the NPC along the shortest path to the target cell,
+// falling back to a random move if there is no usable step
+func (n *NPC) TryMoveToward(mazeObj *maze.Maze, target maze.Position, validMoveFn func(x, y int) bool) bool {
+	if n.Moving || n.HasMoved {
+		return false
 	}
 
-	// Shuffle directions for randomized movement
-	rand.Shuffle(len(directions), func(i, j int) {
-		directions[i], directions[j] = directions[j], directions[i]
-	})
+	path := mazeObj.State.ShortestPath(maze.Position{X: n.GridX, Y: n.GridY}, target)
+	if len(path) >= 2 && validMoveFn(path[1].X, path[1].Y) {
+		n.moveTo(path[1].X, path[1].Y)
+		return true
+	}
+	return n.TryMove(validMoveFn)
+}
 
-	// Try each direction until a valid move is found
-	for _, dir := range directions {
-		newGridX := n.GridX + dir.dx
-		newGridY := n.GridY + dir.dy
+// moveTo starts a smooth move to the given cell and counts the step
+func (n *NPC) moveTo(gridX, gridY int) {
+	// Update grid position
+	n.GridX = gridX
+	n.GridY = gridY
 
-		// Check if movement is valid using the callback
-		if validMoveFn(newGridX, newGridY) {
-			// Update grid position
-			n.GridX = newGridX
-			n.GridY = newGridY
+	// Set destination for smooth movement
+	n.StartX = n.X
+	n.StartY = n.Y
+	n.DestX = float64(n.GridX) * n.TileSize
+	n.DestY = float64(n.GridY) * n.TileSize
+	n.Progress = 0
+	n.Moving = true
 
-			// Set destination for smooth movement
-			n.DestX = float64(newGridX) * n.Size
-			n.DestY = float64(newGridY) * n.Size
-			n.Moving = true
-			n.HasMoved = true
-			return true
-		}
+	// Already there, the next UpdatePosition reports the arrival
+	if n.Instant {
+		n.X = n.DestX
+		n.Y = n.DestY
+		n.Progress = 1
 	}
 
-	// If NPC can't move in any direction, mark as moved anyway
-	n.HasMoved = true
-	return false
+	// Only done once every step for this turn has been taken
+	n.movesMade++
+	n.HasMoved = n.movesMade >= n.MovesPerTurn
 }
 
+// DefaultRotateChance is how likely an NPC is to try a rotation on its turn.
+// NPCs only rotate rows when a chance is chosen in the settings
+const DefaultRotateChance = 0.0
+
 // Manager handles a collection of NPCs
 type Manager struct {
-	NPCs []*NPC
+	NPCs         []*NPC
+	Reserved     map[maze.Position]bool // Destinations claimed during the current NPC phase
+	RotateChance float64                // Probability (0-1) an NPC tries to rotate its row instead of moving
+	LastRotation *Rotation              // Rotation performed by the last ProcessTurn call, if any
+	Acting       *NPC                   // NPC whose move is currently playing out, nil if none
+	Seed         int64                  // Shared seed every NPC's random stream is derived from
+	Rand         *rand.Rand             // Source for the manager's own choices, such as rotating
+}
+
+// Rotation records an NPC rotating its row
+type Rotation struct {
+	NPCID     int
+	Direction int // 1 for right, -1 for left
 }
 
-// NewManager creates a new NPC manager
+// NewManager creates a new NPC manager with a random seed
 func NewManager() *Manager {
+	return NewManagerWithSeed(rand.Int63())
+}
+
+// NewManagerWithSeed creates a new NPC manager whose NPCs make the same
+// choices every time they are given the same seed
+func NewManagerWithSeed(seed int64) *Manager {
 	return &Manager{
-		NPCs: make([]*NPC, 0),
+		NPCs:         make([]*NPC, 0),
+		Reserved:     make(map[maze.Position]bool),
+		RotateChance: DefaultRotateChance,
+		Seed:         seed,
+		Rand:         rand.New(rand.NewSource(seed)),
 	}
 }
 
-// AddNPC adds an NPC to the manager
+// AddNPC adds an NPC to the manager, giving it a random stream derived
+// from the shared seed and its ID unless it already has one
 func (m *Manager) AddNPC(npc *NPC) {
+	if npc.Rand == nil {
+		npc.Rand = rand.New(rand.NewSource(m.Seed + int64(npc.ID+1)*npcSeedStride))
+	}
 	m.NPCs = append(m.NPCs, npc)
 }
 
+// npcSeedStride spaces out the per-NPC seeds so neighbouring IDs don't
+// start from neighbouring seeds
+const npcSeedStride = 7919
+
 // AnyMoving checks if any NPC is currently moving
 func (m *Manager) AnyMoving() bool {
 	for _, npc := range m.NPCs {
@@ -146,7 +260,8 @@ func (m *Manager) AnyMoving() bool {
 	return false
 }
 
-// AllMoved checks if all NPCs have moved this turn
+// AllMoved checks if all NPCs have moved this turn.
+// A manager with no NPCs has always finished, so their phase ends at once
 func (m *Manager) AllMoved() bool {
 	for _, npc := range m.NPCs {
 		if !npc.HasMoved {
@@ -157,28 +272,97 @@ func (m *Manager) AllMoved() bool {
 }
 
 // ResetMovedStatus resets the moved status for all NPCs
+// and clears any destinations reserved during the previous NPC phase
 func (m *Manager) ResetMovedStatus() {
 	for _, npc := range m.NPCs {
 		npc.ResetMovedStatus()
 	}
+	m.ClearReservations()
+	m.Acting = nil
+}
+
+// ActingID returns the ID of the NPC currently taking its turn
+func (m *Manager) ActingID() (int, bool) {
+	if m.Acting == nil {
+		return 0, false
+	}
+	return m.Acting.ID, true
+}
+
+// Reserve claims a cell as an NPC destination for the current phase
+func (m *Manager) Reserve(x, y int) {
+	if m.Reserved == nil {
+		m.Reserved = make(map[maze.Position]bool)
+	}
+	m.Reserved[maze.Position{X: x, Y: y}] = true
+}
+
+// IsReserved checks if a cell has already been claimed this phase
+func (m *Manager) IsReserved(x, y int) bool {
+	return m.Reserved[maze.Position{X: x, Y: y}]
+}
+
+// ClearReservations releases all reserved destinations
+func (m *Manager) ClearReservations() {
+	m.Reserved = make(map[maze.Position]bool)
 }
 
 // ProcessTurn processes the turn for one NPC that hasn't moved yet
-// Returns true if an NPC moved
-func (m *Manager) ProcessTurn(validMoveFn func(x, y int) bool) bool {
+// mazeObj lets NPCs rotate their row to hinder the player at playerPos
+// Returns true if an NPC moved or rotated
+func (m *Manager) ProcessTurn(mazeObj *maze.Maze, playerPos maze.Position, validMoveFn func(x, y int) bool) bool {
+	m.LastRotation = nil
+
+	if len(m.NPCs) == 0 {
+		m.Acting = nil
+		return false // Nobody to move, e.g. in practice
+	}
+
 	if m.AnyMoving() {
 		return false // Wait for movement to complete
 	}
 
 	// If all NPCs have moved, we're done with the turn
 	if m.AllMoved() {
+		m.Acting = nil
 		return false
 	}
 
+	// Treat cells already claimed by another NPC this phase as blocked,
+	// so a second NPC falls back to an alternate direction
+	unreservedMoveFn := func(x, y int) bool {
+		return !m.IsReserved(x, y) && validMoveFn(x, y)
+	}
+
 	// Process NPCs that haven't moved yet
 	for _, npc := range m.NPCs {
 		if !npc.HasMoved && !npc.Moving {
-			if npc.TryMove(validMoveFn) {
+			// Sometimes spend the turn rotating the row against the player
+			m.Acting = npc
+
+			if mazeObj != nil && m.randFloat() < m.RotateChance && m.tryHinderingRotation(npc, mazeObj, playerPos) {
+				npc.HasMoved = true
+				return true
+			}
+
+			// Roll the behavior for the whole turn before its first step
+			if npc.movesMade == 0 {
+				npc.TurnStrategy = npc.Strategy
+				if len(npc.Mix) > 0 {
+					npc.TurnStrategy = npc.pickStrategy(m.randFloat())
+				}
+			}
+
+			moved := false
+			if npc.TurnStrategy == Optimal && mazeObj != nil {
+				moved = npc.TryMoveToGoal(mazeObj, unreservedMoveFn)
+			} else if npc.TurnStrategy == Chase && mazeObj != nil {
+				moved = npc.TryMoveToward(mazeObj, playerPos, unreservedMoveFn)
+			} else {
+				moved = npc.TryMove(unreservedMoveFn)
+			}
+			if moved {
+				m.Reserve(npc.GridX, npc.GridY)
 				return true // An NPC moved
 			}
 		}
@@ -187,13 +371,109 @@ func (m *Manager) ProcessTurn(validMoveFn func(x, y int) bool) bool {
 	return false // No NPCs could move
 }
 
+// randFloat returns a random number in [0, 1) from the manager's source,
+// falling back to the global source for managers built without one
+func (m *Manager) randFloat() float64 {
+	if m.Rand == nil {
+		return rand.Float64()
+	}
+	return m.Rand.Float64()
+}
+
+// Nearest returns the NPC with the shortest walking distance from the
+// given cell, or nil if no NPC can be reached
+func (m *Manager) Nearest(mazeObj *maze.Maze, from maze.Position) *NPC {
+	var nearest *NPC
+	bestLength := 0
+	for _, npc := range m.NPCs {
+		path := mazeObj.State.ShortestPath(from, maze.Position{X: npc.GridX, Y: npc.GridY})
+		if path == nil {
+			continue // Walled off from this cell
+		}
+		if nearest == nil || len(path) < bestLength {
+			nearest, bestLength = npc, len(path)
+		}
+	}
+	return nearest
+}
+
+// entityPositions returns the player's cell followed by every NPC's cell
+func (m *Manager) entityPositions(playerPos maze.Position) []maze.Position {
+	positions := []maze.Position{playerPos}
+	for _, npc := range m.NPCs {
+		positions = append(positions, maze.Position{X: npc.GridX, Y: npc.GridY})
+	}
+	return positions
+}
+
+// tryHinderingRotation rotates the NPC's row in whichever direction
+// lengthens the player's shortest path to the goal the most.
+// Rotations that drop a wall on an entity or cut anyone off from the goal
+// are never kept. Returns true if a rotation was performed
+func (m *Manager) tryHinderingRotation(n *NPC, mazeObj *maze.Maze, playerPos maze.Position) bool {
+	goal, ok := mazeObj.State.FindGoal()
+	if !ok {
+		return false
+	}
+
+	currentPath := mazeObj.State.ShortestPath(playerPos, goal)
+	if currentPath == nil {
+		return false
+	}
+
+	entities := m.entityPositions(playerPos)
+	original := mazeObj.State.Row(n.GridY)
+	bestDirection, bestLength := 0, len(currentPath)
+
+	// Try each direction, always restoring the row afterwards
+	for _, direction := range []int{-1, 1} {
+		if mazeObj.CheckXRotateCollisions(n.GridX, n.GridY, direction, entities) {
+			continue
+		}
+
+		mazeObj.PerformXRotate(n.GridX, n.GridY, direction)
+		if length, ok := hinderedPathLength(mazeObj, playerPos, entities); ok && length > bestLength {
+			bestDirection, bestLength = direction, length
+		}
+		mazeObj.State.SetRow(n.GridY, original)
+	}
+
+	if bestDirection == 0 {
+		return false // No rotation would slow the player down
+	}
+
+	mazeObj.PerformXRotate(n.GridX, n.GridY, bestDirection)
+	if goal, ok := mazeObj.State.FindGoal(); ok {
+		mazeObj.State.GoalX, mazeObj.State.GoalY = goal.X, goal.Y
+	}
+
+	m.LastRotation = &Rotation{NPCID: n.ID, Direction: bestDirection}
+	return true
+}
+
+// hinderedPathLength returns the player's shortest path length to the goal,
+// or false if any entity can no longer reach the goal
+func hinderedPathLength(mazeObj *maze.Maze, playerPos maze.Position, entities []maze.Position) (int, bool) {
+	for _, pos := range entities {
+		if !mazeObj.CanReachGoal(pos) {
+			return 0, false
+		}
+	}
+
+	goal, ok := mazeObj.State.FindGoal()
+	if !ok {
+		return 0, false
+	}
+	return len(mazeObj.State.ShortestPath(playerPos, goal)), true
+}
+
 // UpdatePositions updates positions for all NPCs
 // Returns a slice of NPCs that reached their destinations this frame
-func (m *Manager) UpdatePositions(moveSpeed float64) []*NPC {
+func (m *Manager) UpdatePositions(speed, dt float64) []*NPC {
 	arrivedNPCs := make([]*NPC, 0)
 	
 	for _, npc := range m.NPCs {
-		if npc.UpdatePosition(moveSpeed) {
+		if npc.UpdatePosition(speed, dt) {
 			arrivedNPCs = append(arrivedNPCs, npc)
 		}
 	}
@@ -0,0 +1,366 @@
+// internal/game/npc/npc_test.go
+package npc
+
+import (
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/JacobCromwell/Mazenasium/internal/game/maze"
+)
+
+// buildMaze turns rows of '#' (wall), '.' (floor) and 'G' (goal) into a maze
+func buildMaze(rows ...string) *maze.Maze {
+	state := maze.NewState(len(rows[0]), len(rows))
+	for y, row := range rows {
+		for x, c := range row {
+			switch c {
+			case '.':
+				state.SetTileType(x, y, maze.Floor)
+			case 'G':
+				state.SetTileType(x, y, maze.Goal)
+				state.GoalX, state.GoalY = x, y
+			}
+		}
+	}
+	return &maze.Maze{State: state, Generator: maze.NewGenerator(1), TileSize: maze.TileSize}
+}
+
+// newTestNPC creates an NPC that snaps onto its destination
+func newTestNPC(id, x, y int) *NPC {
+	n := New(id, x, y, maze.TileSize, color.RGBA{R: 255, A: 255})
+	n.Instant = true
+	return n
+}
+
+// runPhase processes NPC turns until every NPC has moved, finishing each slide
+func runPhase(m *Manager, mazeObj *maze.Maze, playerPos maze.Position, validMoveFn func(x, y int) bool) {
+	for i := 0; i < 100 && !m.AllMoved(); i++ {
+		m.ProcessTurn(mazeObj, playerPos, validMoveFn)
+		m.UpdatePositions(1, 0)
+	}
+}
+
+func TestReservedCellIsNotClaimedTwice(t *testing.T) {
+	mazeObj := buildMaze(
+		"#####",
+		"#...#",
+		"#####",
+	)
+
+	m := NewManagerWithSeed(1)
+	m.RotateChance = 0
+	first, second := newTestNPC(0, 1, 1), newTestNPC(1, 3, 1)
+	m.AddNPC(first)
+	m.AddNPC(second)
+
+	// (2,1) is the only open neighbour of both NPCs
+	runPhase(m, nil, maze.Position{}, mazeObj.IsValidMove)
+
+	if first.GridX != 2 || first.GridY != 1 {
+		t.Fatalf("first NPC at (%d,%d), want (2,1)", first.GridX, first.GridY)
+	}
+	if second.GridX != 3 || second.GridY != 1 {
+		t.Errorf("second NPC at (%d,%d), want it to stay on (3,1)", second.GridX, second.GridY)
+	}
+	if !m.IsReserved(2, 1) {
+		t.Error("(2,1) should be reserved for the rest of the phase")
+	}
+}
+
+func TestSecondNPCFallsBackToAlternateMove(t *testing.T) {
+	mazeObj := buildMaze(
+		"#####",
+		"#...#",
+		"###.#",
+		"#####",
+	)
+
+	m := NewManagerWithSeed(1)
+	m.RotateChance = 0
+	first, second := newTestNPC(0, 1, 1), newTestNPC(1, 3, 1)
+	m.AddNPC(first)
+	m.AddNPC(second)
+
+	runPhase(m, nil, maze.Position{}, mazeObj.IsValidMove)
+
+	if first.GridX != 2 || first.GridY != 1 {
+		t.Fatalf("first NPC at (%d,%d), want (2,1)", first.GridX, first.GridY)
+	}
+	if second.GridX != 3 || second.GridY != 2 {
+		t.Errorf("second NPC at (%d,%d), want the alternate (3,2)", second.GridX, second.GridY)
+	}
+}
+
+func TestResetMovedStatusClearsReservations(t *testing.T) {
+	m := NewManagerWithSeed(1)
+	m.Reserve(2, 1)
+	m.ResetMovedStatus()
+
+	if m.IsReserved(2, 1) {
+		t.Error("reservations should be cleared for the next NPC phase")
+	}
+}
+
+func TestNewPositionsByTileSize(t *testing.T) {
+	for _, tileSize := range []float64{20, 30, 48} {
+		n := New(0, 3, 2, tileSize, color.RGBA{A: 255})
+
+		if n.X != 3*tileSize || n.Y != 2*tileSize {
+			t.Errorf("tile size %v: position (%v,%v), want (%v,%v)", tileSize, n.X, n.Y, 3*tileSize, 2*tileSize)
+		}
+		if n.Size != tileSize || n.TileSize != tileSize {
+			t.Errorf("tile size %v: Size %v, TileSize %v", tileSize, n.Size, n.TileSize)
+		}
+
+		n.Teleport(5, 4)
+		if n.X != 5*tileSize || n.Y != 4*tileSize {
+			t.Errorf("tile size %v: teleported to (%v,%v), want (%v,%v)", tileSize, n.X, n.Y, 5*tileSize, 4*tileSize)
+		}
+	}
+}
+
+func TestDefaultManagerNeverRotates(t *testing.T) {
+	if NewManagerWithSeed(1).RotateChance != 0 {
+		t.Error("NPCs should only rotate rows once a chance is chosen")
+	}
+}
+
+func TestRotationNeverDropsWallOnEntity(t *testing.T) {
+	// Rotating row 1 either way moves a wall onto the player at (3,1)
+	mazeObj := buildMaze(
+		"#######",
+		"#.#.#.#",
+		"#.....#",
+		"#....G#",
+		"#######",
+	)
+	before := mazeObj.State.Row(1)
+
+	m := NewManagerWithSeed(1)
+	m.RotateChance = 1
+	m.AddNPC(newTestNPC(0, 1, 1))
+	m.ProcessTurn(mazeObj, maze.Position{X: 3, Y: 1}, mazeObj.IsValidMove)
+
+	if m.LastRotation != nil {
+		t.Fatalf("NPC rotated %d although every rotation hits the player", m.LastRotation.Direction)
+	}
+	for x, tile := range mazeObj.State.Row(1) {
+		if tile != before[x] {
+			t.Fatalf("row 1 changed at column %d", x)
+		}
+	}
+}
+
+func TestRotationKeepsGoalReachable(t *testing.T) {
+	rotations := 0
+	for seed := int64(1); seed <= 20; seed++ {
+		mazeObj := maze.NewWithConfig(maze.Config{Width: 21, Height: 21, Seed: seed, ExtraPathFactor: maze.DefaultExtraPathFactor})
+		playerPos := mazeObj.StartPosition()
+
+		m := NewManagerWithSeed(seed)
+		m.RotateChance = 1
+		for i, spawn := range mazeObj.SpawnPositions() {
+			m.AddNPC(newTestNPC(i, spawn.X, spawn.Y))
+		}
+
+		for turn := 0; turn < 5; turn++ {
+			m.ResetMovedStatus()
+			for i := 0; i < 100 && !m.AllMoved(); i++ {
+				m.ProcessTurn(mazeObj, playerPos, mazeObj.IsValidMove)
+				m.UpdatePositions(1, 0)
+				if m.LastRotation != nil {
+					rotations++
+				}
+
+				for _, pos := range m.entityPositions(playerPos) {
+					if mazeObj.IsWall(pos.X, pos.Y) {
+						t.Fatalf("seed %d: a wall was dropped on the entity at %v", seed, pos)
+					}
+					if !mazeObj.CanReachGoal(pos) {
+						t.Fatalf("seed %d: the entity at %v was cut off from the goal", seed, pos)
+					}
+				}
+			}
+		}
+	}
+
+	if rotations == 0 {
+		t.Error("no NPC ever rotated, the guards weren't exercised")
+	}
+}
+
+func TestActingIDFollowsTheMovingNPC(t *testing.T) {
+	mazeObj := buildMaze(
+		"#######",
+		"#.....#",
+		"#######",
+	)
+
+	m := NewManagerWithSeed(1)
+	m.RotateChance = 0
+	first, second := newTestNPC(4, 1, 1), newTestNPC(7, 5, 1)
+	first.Instant, second.Instant = false, false
+	m.AddNPC(first)
+	m.AddNPC(second)
+
+	if _, ok := m.ActingID(); ok {
+		t.Fatal("no NPC should be acting before the phase starts")
+	}
+
+	for _, want := range []*NPC{first, second} {
+		m.ProcessTurn(mazeObj, maze.Position{}, mazeObj.IsValidMove)
+		if id, ok := m.ActingID(); !ok || id != want.ID {
+			t.Errorf("ActingID() = %d, %v while NPC %d moves", id, ok, want.ID)
+		}
+		if !want.Moving {
+			t.Fatalf("NPC %d should be sliding to its destination", want.ID)
+		}
+		for i := 0; i < 100 && want.Moving; i++ {
+			m.UpdatePositions(maze.TileSize, 1)
+		}
+	}
+
+	m.ProcessTurn(mazeObj, maze.Position{}, mazeObj.IsValidMove)
+	if id, ok := m.ActingID(); ok {
+		t.Errorf("NPC %d still acting after every NPC moved", id)
+	}
+}
+
+func TestTwoMovesPerTurnAdvancesTwoCells(t *testing.T) {
+	mazeObj := buildMaze(
+		"#######",
+		"#.....#",
+		"#######",
+	)
+
+	m := NewManagerWithSeed(1)
+	m.RotateChance = 0
+	n := newTestNPC(0, 1, 1)
+	n.MovesPerTurn = 2
+	m.AddNPC(n)
+
+	// Only moving right is allowed, so the path is clear for two steps
+	runPhase(m, nil, maze.Position{}, func(x, y int) bool {
+		return mazeObj.IsValidMove(x, y) && x > n.GridX
+	})
+
+	if n.GridX != 3 || n.GridY != 1 {
+		t.Errorf("NPC at (%d,%d) after a turn with 2 moves, want (3,1)", n.GridX, n.GridY)
+	}
+}
+
+func TestTwoMovesPerTurnStopsWhenBlocked(t *testing.T) {
+	mazeObj := buildMaze(
+		"####",
+		"#..#",
+		"####",
+	)
+
+	m := NewManagerWithSeed(1)
+	m.RotateChance = 0
+	n := newTestNPC(0, 1, 1)
+	n.MovesPerTurn = 2
+	m.AddNPC(n)
+
+	// The player stands on the cell the NPC leaves, so its second step has nowhere to go
+	runPhase(m, nil, maze.Position{}, func(x, y int) bool {
+		return mazeObj.IsValidMove(x, y) && !(x == 1 && y == 1)
+	})
+
+	if n.GridX != 2 || n.GridY != 1 {
+		t.Errorf("NPC at (%d,%d), want one step to (2,1)", n.GridX, n.GridY)
+	}
+	if !m.AllMoved() {
+		t.Error("a blocked NPC should still finish its turn")
+	}
+}
+
+func TestNPCDisplacementIndependentOfTickRate(t *testing.T) {
+	// 0.1 seconds of a 300px/s slide at 30 and at 120 updates per second
+	positions := map[int]float64{}
+	for _, fps := range []int{30, 120} {
+		n := New(1, 0, 0, 100, color.RGBA{R: 255, A: 255})
+		n.moveTo(1, 0)
+		for frame := 0; frame < fps/10; frame++ {
+			n.UpdatePosition(300, 1/float64(fps))
+		}
+		positions[fps] = n.X
+	}
+
+	if positions[30] <= 0 || positions[30] >= 100 {
+		t.Fatalf("x=%v after 0.1s, want the NPC partway across the tile", positions[30])
+	}
+	if math.Abs(positions[120]-positions[30]) > 1e-9 {
+		t.Errorf("120 FPS moved to x=%v, 30 FPS to x=%v", positions[120], positions[30])
+	}
+}
+
+// positionHistory runs 20 NPC turns on a seeded maze and returns every
+// NPC's cell after each turn
+func positionHistory(seed int64) [][]maze.Position {
+	mazeObj := maze.NewWithConfig(maze.Config{Width: 21, Height: 21, Seed: seed, ExtraPathFactor: maze.DefaultExtraPathFactor})
+	playerPos := mazeObj.StartPosition()
+
+	m := NewManagerWithSeed(seed)
+	m.RotateChance = 0.3
+	for i, spawn := range mazeObj.SpawnPositions() {
+		n := newTestNPC(i, spawn.X, spawn.Y)
+		if i%2 == 1 {
+			n.Strategy = Chase
+			n.Mix = Mixed(Chase, 0.5)
+		}
+		m.AddNPC(n)
+	}
+
+	history := [][]maze.Position{}
+	for turn := 0; turn < 20; turn++ {
+		m.ResetMovedStatus()
+		runPhase(m, mazeObj, playerPos, mazeObj.IsValidMove)
+
+		cells := []maze.Position{}
+		for _, n := range m.NPCs {
+			cells = append(cells, maze.Position{X: n.GridX, Y: n.GridY})
+		}
+		history = append(history, cells)
+	}
+	return history
+}
+
+func TestSameSeedGivesSameNPCHistory(t *testing.T) {
+	for _, seed := range []int64{1, 7, 42} {
+		first, second := positionHistory(seed), positionHistory(seed)
+		if len(first[0]) == 0 {
+			t.Fatalf("seed %d: no NPCs spawned", seed)
+		}
+
+		for turn := range first {
+			for i := range first[turn] {
+				if first[turn][i] != second[turn][i] {
+					t.Fatalf("seed %d turn %d: NPC %d at %v, then at %v on the rerun", seed, turn+1, i, first[turn][i], second[turn][i])
+				}
+			}
+		}
+		if first[0][0] == first[len(first)-1][0] && first[0][0] == first[len(first)/2][0] {
+			t.Errorf("seed %d: the first NPC never moved, the history proves little", seed)
+		}
+	}
+}
+
+func TestInstantNPCArrivesInOneUpdate(t *testing.T) {
+	mazeObj := buildMaze(
+		"#####",
+		"#..G#",
+		"#####",
+	)
+	n := newTestNPC(0, 1, 1)
+	if !n.TryMoveToGoal(mazeObj, mazeObj.IsValidMove) {
+		t.Fatal("the NPC didn't step toward the goal")
+	}
+	if !n.UpdatePosition(1, 0) {
+		t.Fatal("the first update after an instant step didn't report the arrival")
+	}
+	if n.X != 2*maze.TileSize || n.Y != maze.TileSize || n.IsMoving() {
+		t.Errorf("NPC at (%v,%v) moving %v, want it settled on (2,1)", n.X, n.Y, n.IsMoving())
+	}
+}
@@ -0,0 +1,71 @@
+// internal/game/npc/pattern.go
+package npc
+
+import (
+	"math/rand"
+)
+
+// MovementPattern decides which cells an NPC may move to from its position
+type MovementPattern int
+
+const (
+	Orthogonal MovementPattern = iota // One step left, right, up or down
+	Diagonal                          // One step along a diagonal
+	Knight                            // L-shaped jump that ignores walls in between
+)
+
+// Offset is a relative move on the grid
+type Offset struct {
+	DX, DY int
+}
+
+// String returns the display name of the pattern
+func (p MovementPattern) String() string {
+	switch p {
+	case Diagonal:
+		return "Diagonal"
+	case Knight:
+		return "Knight"
+	default:
+		return "Orthogonal"
+	}
+}
+
+// Offsets returns every relative move the pattern allows
+func (p MovementPattern) Offsets() []Offset {
+	switch p {
+	case Diagonal:
+		return []Offset{{-1, -1}, {1, -1}, {-1, 1}, {1, 1}}
+	case Knight:
+		return []Offset{
+			{1, 2}, {2, 1}, {2, -1}, {1, -2},
+			{-1, -2}, {-2, -1}, {-2, 1}, {-1, 2},
+		}
+	default:
+		return []Offset{{-1, 0}, {1, 0}, {0, -1}, {0, 1}}
+	}
+}
+
+// CandidateMoves returns the cells reachable from (x, y) that pass
+// validMoveFn, in an order shuffled by r (the global source if nil)
+func (p MovementPattern) CandidateMoves(x, y int, validMoveFn func(x, y int) bool, r *rand.Rand) []Offset {
+	offsets := p.Offsets()
+
+	// Shuffle directions for randomized movement
+	shuffle := rand.Shuffle
+	if r != nil {
+		shuffle = r.Shuffle
+	}
+	shuffle(len(offsets), func(i, j int) {
+		offsets[i], offsets[j] = offsets[j], offsets[i]
+	})
+
+	// Only the destination matters, so knights jump over walls
+	candidates := []Offset{}
+	for _, offset := range offsets {
+		if validMoveFn(x+offset.DX, y+offset.DY) {
+			candidates = append(candidates, offset)
+		}
+	}
+	return candidates
+}
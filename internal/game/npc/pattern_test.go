@@ -0,0 +1,62 @@
+// internal/game/npc/pattern_test.go
+package npc
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestPatternsProposeOnlyLegalOffsets(t *testing.T) {
+	for _, pattern := range []MovementPattern{Orthogonal, Diagonal, Knight} {
+		legal := map[Offset]bool{}
+		for _, offset := range pattern.Offsets() {
+			legal[offset] = true
+		}
+
+		candidates := pattern.CandidateMoves(5, 5, func(x, y int) bool { return true }, rand.New(rand.NewSource(1)))
+		if len(candidates) != len(legal) {
+			t.Errorf("%v: %d candidates on an open grid, want %d", pattern, len(candidates), len(legal))
+		}
+		for _, offset := range candidates {
+			if !legal[offset] {
+				t.Errorf("%v proposed %v, which isn't one of its moves", pattern, offset)
+			}
+			dx, dy := offset.DX*offset.DX, offset.DY*offset.DY
+			switch pattern {
+			case Orthogonal:
+				if dx+dy != 1 {
+					t.Errorf("orthogonal move %v isn't a single step", offset)
+				}
+			case Diagonal:
+				if dx != 1 || dy != 1 {
+					t.Errorf("diagonal move %v isn't along a diagonal", offset)
+				}
+			case Knight:
+				if dx*dy != 4 {
+					t.Errorf("knight move %v isn't L-shaped", offset)
+				}
+			}
+		}
+	}
+}
+
+func TestPatternsRespectTheValidityCallback(t *testing.T) {
+	for _, pattern := range []MovementPattern{Orthogonal, Diagonal, Knight} {
+		// Only cells to the right of the NPC are open
+		valid := func(x, y int) bool { return x > 5 }
+
+		candidates := pattern.CandidateMoves(5, 5, valid, rand.New(rand.NewSource(1)))
+		if len(candidates) == 0 {
+			t.Errorf("%v found no move although cells to the right are open", pattern)
+		}
+		for _, offset := range candidates {
+			if !valid(5+offset.DX, 5+offset.DY) {
+				t.Errorf("%v proposed %v into a cell the callback refused", pattern, offset)
+			}
+		}
+
+		if got := pattern.CandidateMoves(5, 5, func(x, y int) bool { return false }, nil); len(got) != 0 {
+			t.Errorf("%v proposed %v with every cell refused", pattern, got)
+		}
+	}
+}
@@ -1,75 +1,103 @@
 package player
 
 import (
-	"math"
+	"github.com/JacobCromwell/Mazenasium/internal/game/motion"
 )
 
 // Constants related to player
 const (
-	Size = 28 // Player size (reduced from 38 to match smaller tile size)
+	Padding = 2 // Gap in pixels between the player and the tile edges
+
+	MaxMovesPerTurn = 3 // Most steps the player may take in one turn
 )
 
 // Player represents the player character
 type Player struct {
-	GridX, GridY int
-	X, Y         float64 // Actual position for smooth movement
-	DestX, DestY float64 // Destination for smooth movement
-	Moving       bool
-	Size         float64
+	GridX, GridY   int
+	X, Y           float64       // Actual position for smooth movement
+	DestX, DestY   float64       // Destination for smooth movement
+	StartX, StartY float64       // Where the current slide began
+	Progress       float64       // How far along the current slide, 0.0 to 1.0
+	Easing         motion.Easing // Curve the slide follows
+	Instant        bool          // Skip the slide and snap straight onto the destination
+	Moving         bool
+	Size           float64 // Drawn size in pixels
+	TileSize       float64 // Size of a grid cell in pixels
 }
 
 // New creates a new player with the given initial grid position
 func New(gridX, gridY int, tileSize float64) *Player {
 	x := float64(gridX) * tileSize
 	y := float64(gridY) * tileSize
-	
+
 	return &Player{
-		GridX:  gridX,
-		GridY:  gridY,
-		X:      x,
-		Y:      y,
-		DestX:  x,
-		DestY:  y,
-		Moving: false,
-		Size:   Size,
+		GridX:    gridX,
+		GridY:    gridY,
+		X:        x,
+		Y:        y,
+		DestX:    x,
+		DestY:    y,
+		Moving:   false,
+		Size:     tileSize - Padding,
+		TileSize: tileSize,
 	}
 }
 
 // SetDestination sets a new destination for the player to move to
 func (p *Player) SetDestination(gridX, gridY int, tileSize float64) {
+	p.TileSize = tileSize
 	p.GridX = gridX
 	p.GridY = gridY
+	p.StartX = p.X
+	p.StartY = p.Y
 	p.DestX = float64(gridX) * tileSize
 	p.DestY = float64(gridY) * tileSize
+	p.Progress = 0
 	p.Moving = true
+
+	// Already there, the next Update reports the arrival
+	if p.Instant {
+		p.X = p.DestX
+		p.Y = p.DestY
+		p.Progress = 1
+	}
+}
+
+// Teleport places the player on a cell instantly, without smooth movement
+func (p *Player) Teleport(gridX, gridY int, tileSize float64) {
+	p.TileSize = tileSize
+	p.GridX = gridX
+	p.GridY = gridY
+	p.X = float64(gridX) * tileSize
+	p.Y = float64(gridY) * tileSize
+	p.DestX = p.X
+	p.DestY = p.Y
+	p.Moving = false
 }
 
 // Update updates the player's position with smooth movement
+// speed is in pixels per second and dt the seconds since the last update
 // Returns true if the player has arrived at the destination
-func (p *Player) Update(moveSpeed float64) bool {
+func (p *Player) Update(speed, dt float64) bool {
 	if !p.Moving {
 		return false
 	}
-	
-	dx := p.DestX - p.X
-	dy := p.DestY - p.Y
-	
-	if math.Abs(dx) < moveSpeed && math.Abs(dy) < moveSpeed {
+
+	distance := motion.Distance(p.StartX, p.StartY, p.DestX, p.DestY)
+	p.Progress = motion.Advance(p.Progress, distance, speed, dt)
+	if p.Progress >= 1 {
 		// Arrived at destination
 		p.X = p.DestX
 		p.Y = p.DestY
 		p.Moving = false
 		return true
-	} else {
-		// Move toward destination
-		if dx != 0 {
-			p.X += math.Copysign(moveSpeed, dx)
-		}
-		if dy != 0 {
-			p.Y += math.Copysign(moveSpeed, dy)
-		}
-		return false
 	}
+
+	// Place the player along the eased curve between start and destination
+	eased := p.Easing.Apply(p.Progress)
+	p.X = p.StartX + (p.DestX-p.StartX)*eased
+	p.Y = p.StartY + (p.DestY-p.StartY)*eased
+	return false
 }
 
 // IsMoving returns whether the player is currently moving
@@ -85,4 +113,4 @@ func (p *Player) GetGridPosition() (int, int) {
 // GetPosition returns the current pixel position of the player
 func (p *Player) GetPosition() (float64, float64) {
 	return p.X, p.Y
-}
\ No newline at end of file
+}
@@ -0,0 +1,109 @@
+// internal/game/player/player_test.go
+package player
+
+import (
+	"math"
+	"testing"
+
+	"github.com/JacobCromwell/Mazenasium/internal/game/motion"
+)
+
+func TestNewPositionsByTileSize(t *testing.T) {
+	for _, tileSize := range []float64{20, 30, 48} {
+		p := New(3, 2, tileSize)
+
+		if x, y := p.GetPosition(); x != 3*tileSize || y != 2*tileSize {
+			t.Errorf("tile size %v: position (%v,%v), want (%v,%v)", tileSize, x, y, 3*tileSize, 2*tileSize)
+		}
+		if p.Size != tileSize-Padding {
+			t.Errorf("tile size %v: Size = %v, want %v", tileSize, p.Size, tileSize-Padding)
+		}
+	}
+}
+
+func TestDestinationUsesTileSize(t *testing.T) {
+	p := New(1, 1, 40)
+	p.SetDestination(2, 1, 40)
+
+	if p.DestX != 80 || p.DestY != 40 {
+		t.Errorf("destination (%v,%v), want (80,40)", p.DestX, p.DestY)
+	}
+
+	// A step at 40px takes longer than one frame at the usual speed
+	if p.Update(300, 1.0/60) {
+		t.Fatal("arrived after a single frame")
+	}
+	arrived := false
+	for i := 0; i < 60 && !arrived; i++ {
+		arrived = p.Update(300, 1.0/60)
+	}
+	if x, y := p.GetPosition(); x != 80 || y != 40 {
+		t.Errorf("arrived at (%v,%v), want (80,40)", x, y)
+	}
+}
+
+func TestDisplacementIndependentOfTickRate(t *testing.T) {
+	// 0.1 seconds of a 300px/s slide across a 100px tile at each rate
+	rates := []int{30, 60, 120}
+	positions := map[int]float64{}
+	for _, fps := range rates {
+		p := New(0, 0, 100)
+		p.SetDestination(1, 0, 100)
+		for frame := 0; frame < fps/10; frame++ {
+			p.Update(300, 1/float64(fps))
+		}
+		positions[fps] = p.X
+	}
+
+	want := positions[rates[0]]
+	if want <= 0 || want >= 100 {
+		t.Fatalf("x=%v after 0.1s, want the player partway across the tile", want)
+	}
+	for _, fps := range rates[1:] {
+		if math.Abs(positions[fps]-want) > 1e-9 {
+			t.Errorf("%d FPS moved to x=%v, %d FPS to x=%v", fps, positions[fps], rates[0], want)
+		}
+	}
+}
+
+func TestEaseInOutVariesSpeedAndArrivesExactly(t *testing.T) {
+	p := New(1, 1, 40)
+	p.Easing = motion.EaseInOut
+	p.SetDestination(2, 1, 40)
+
+	steps := []float64{}
+	previous := p.X
+	for frame := 0; frame < 120 && p.IsMoving(); frame++ {
+		p.Update(300, 1.0/60)
+		steps = append(steps, p.X-previous)
+		previous = p.X
+	}
+	if p.IsMoving() {
+		t.Fatal("the eased slide never arrived")
+	}
+	if p.X != 80 || p.Y != 40 {
+		t.Errorf("arrived at (%v,%v), want exactly (80,40)", p.X, p.Y)
+	}
+
+	// Slow out of the start, faster through the middle
+	middle := steps[len(steps)/2]
+	if len(steps) < 3 || steps[0] >= middle {
+		t.Errorf("per-frame steps %v, want the first slower than the middle", steps)
+	}
+}
+
+func TestInstantMoveArrivesInOneUpdate(t *testing.T) {
+	p := New(1, 1, 40)
+	p.Instant = true
+	p.SetDestination(2, 1, 40)
+
+	if x, y := p.GetPosition(); x != 80 || y != 40 {
+		t.Errorf("instant step left the player at (%v,%v), want (80,40)", x, y)
+	}
+	if !p.Update(300, 1.0/60) {
+		t.Fatal("the first update after an instant step didn't report the arrival")
+	}
+	if p.IsMoving() || p.Update(300, 1.0/60) {
+		t.Error("the arrival was reported more than once")
+	}
+}
@@ -0,0 +1,35 @@
+// internal/game/score/score.go
+package score
+
+// TriviaPoints is awarded for each correctly answered trivia question
+const TriviaPoints = 1
+
+// Keeper tracks the player's points during a match
+type Keeper struct {
+	Points int
+}
+
+// New creates a keeper starting at zero points
+func New() *Keeper {
+	return &Keeper{}
+}
+
+// Add awards points
+func (k *Keeper) Add(points int) {
+	if points > 0 {
+		k.Points += points
+	}
+}
+
+// Deduct removes up to the given number of points, never going below zero
+// Returns how many points were actually removed
+func (k *Keeper) Deduct(points int) int {
+	if points <= 0 {
+		return 0
+	}
+	if points > k.Points {
+		points = k.Points
+	}
+	k.Points -= points
+	return points
+}
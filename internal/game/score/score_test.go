@@ -0,0 +1,28 @@
+// internal/game/score/score_test.go
+package score
+
+import "testing"
+
+func TestDeductStopsAtZero(t *testing.T) {
+	k := New()
+	k.Add(5)
+
+	if taken := k.Deduct(3); taken != 3 || k.Points != 2 {
+		t.Errorf("Deduct(3) from 5 took %d leaving %d, want 3 leaving 2", taken, k.Points)
+	}
+	if taken := k.Deduct(3); taken != 2 || k.Points != 0 {
+		t.Errorf("Deduct(3) from 2 took %d leaving %d, want 2 leaving 0", taken, k.Points)
+	}
+	if taken := k.Deduct(3); taken != 0 || k.Points != 0 {
+		t.Errorf("Deduct(3) from 0 took %d leaving %d, want nothing taken", taken, k.Points)
+	}
+}
+
+func TestNegativeAmountsAreIgnored(t *testing.T) {
+	k := New()
+	k.Add(4)
+	k.Add(-2)
+	if taken := k.Deduct(-2); taken != 0 || k.Points != 4 {
+		t.Errorf("negative amounts changed the score to %d", k.Points)
+	}
+}
@@ -0,0 +1,59 @@
+// internal/game/state/coop.go
+package state
+
+import (
+	"github.com/JacobCromwell/Mazenasium/internal/game/player"
+)
+
+// teamWinner is the winner recorded when a co-op team reaches the goal
+const teamWinner = "Team"
+
+// coop checks if two humans share this match. Only matches started from
+// the menu are played as a team
+func (m *Manager) coop() bool {
+	return len(m.humans) > 1
+}
+
+// setupCoop adds the second human when co-op is turned on. Both start on the
+// start cell and take their turns one after the other before the NPCs move
+func (m *Manager) setupCoop() {
+	if !m.Config.CoopMode {
+		return
+	}
+
+	start := m.Maze.StartPosition()
+	partner := player.New(start.X, start.Y, m.Maze.GetTileSize())
+	partner.Easing = m.Config.MoveEasing
+	partner.Instant = m.Config.InstantMovement
+	m.humans = []*player.Player{m.Player, partner}
+	m.TurnManager.Humans = len(m.humans)
+	m.UIRenderer.Partner = partner
+}
+
+// activateHuman makes Player the human whose turn it is, so movement, trivia
+// and actions all act on them. Their teammate is drawn waiting
+func (m *Manager) activateHuman() {
+	if !m.coop() {
+		return
+	}
+	current := m.TurnManager.CurrentHuman
+	m.Player = m.humans[current]
+	m.UIRenderer.Partner = m.humans[(current+1)%len(m.humans)]
+}
+
+// playerWinner returns the winner to record when a human wins. In co-op
+// the whole team shares the win, whoever got there
+func (m *Manager) playerWinner() string {
+	if m.coop() {
+		return teamWinner
+	}
+	return "Player"
+}
+
+// humanPlayers returns every human in the match
+func (m *Manager) humanPlayers() []*player.Player {
+	if m.coop() {
+		return m.humans
+	}
+	return []*player.Player{m.Player}
+}
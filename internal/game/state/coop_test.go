@@ -0,0 +1,109 @@
+// internal/game/state/coop_test.go
+package state
+
+import (
+	"testing"
+
+	"github.com/JacobCromwell/Mazenasium/internal/game/action"
+	"github.com/JacobCromwell/Mazenasium/internal/game/config"
+	"github.com/JacobCromwell/Mazenasium/internal/game/turn"
+	"github.com/JacobCromwell/Mazenasium/internal/game/ui"
+)
+
+// newCoopMatch starts a co-op match on a corridor with the first human one
+// step left of the goal and the second one step right of it
+func newCoopMatch(t *testing.T) *Manager {
+	t.Helper()
+	cfg := config.Default()
+	cfg.CoopMode = true
+	cfg.InstantMovement = true
+	cfg.TriviaFrequency = 0
+	m, _ := newTestManager(t, cfg)
+	m.startMatch()
+	useGrid(m,
+		"#######",
+		"#..G..#",
+		"#######",
+	)
+	if len(m.humans) != 2 {
+		t.Fatalf("%d humans in a co-op match, want 2", len(m.humans))
+	}
+	m.humans[0].Teleport(2, 1, m.Maze.GetTileSize())
+	m.humans[1].Teleport(4, 1, m.Maze.GetTileSize())
+	m.NPCManager.NPCs = nil
+	return m
+}
+
+func TestEitherHumanWinsForTheTeam(t *testing.T) {
+	t.Run("first", func(t *testing.T) {
+		m := newCoopMatch(t)
+		m.TurnManager.NextState(turn.WaitingForMove)
+		if m.Player != m.humans[0] {
+			t.Fatal("the first human doesn't start")
+		}
+		m.movePlayer(1, 0)
+
+		if m.CurrentState != GameOver || m.Winner != teamWinner || m.WinReason != ui.ReachedGoal {
+			t.Errorf("state %v winner %q reason %v, want a team win at the goal", m.CurrentState, m.Winner, m.WinReason)
+		}
+	})
+
+	t.Run("second", func(t *testing.T) {
+		m := newCoopMatch(t)
+		m.endPlayerTurn()
+		if m.Player != m.humans[1] || !m.TurnManager.IsPlayerTurn() {
+			t.Fatal("the second human didn't get the next turn")
+		}
+		m.TurnManager.NextState(turn.WaitingForMove)
+		m.movePlayer(-1, 0)
+
+		if m.CurrentState != GameOver || m.Winner != teamWinner || m.WinReason != ui.ReachedGoal {
+			t.Errorf("state %v winner %q reason %v, want a team win at the goal", m.CurrentState, m.Winner, m.WinReason)
+		}
+	})
+}
+
+func TestRotationOntoThePartnerIsBlocked(t *testing.T) {
+	m := newCoopMatch(t)
+	// Rotating the active human's row right moves the wall at (2,1) onto
+	// their waiting teammate at (3,1)
+	useGrid(m,
+		"#######",
+		"#.#...#",
+		"#....G#",
+		"#######",
+	)
+	m.humans[0].Teleport(5, 1, m.Maze.GetTileSize())
+	m.humans[1].Teleport(3, 1, m.Maze.GetTileSize())
+	before := gridTypes(m)
+
+	m.handleActionSelection(action.Action{Type: action.XRotateRight})
+	m.confirmXRotate()
+
+	if !sameGrid(gridTypes(m), before) {
+		t.Error("the rotation dropped a wall on the waiting teammate")
+	}
+	if m.ActionMgr.Cooldowns[action.XRotateRight] > 0 {
+		t.Error("a blocked rotation shouldn't spend the action")
+	}
+}
+
+func TestShrinkPushesThePartnerInward(t *testing.T) {
+	m := newCoopMatch(t)
+	useShrinkGrid(m)
+	m.humans[0].Teleport(3, 3, m.Maze.GetTileSize())
+	m.humans[1].Teleport(1, 1, m.Maze.GetTileSize())
+
+	m.shrinkMaze()
+
+	if x, y := m.humans[0].GetGridPosition(); x != 3 || y != 3 {
+		t.Errorf("active human moved to (%d,%d), want them left on (3,3)", x, y)
+	}
+	x, y := m.humans[1].GetGridPosition()
+	if m.Maze.IsWall(x, y) || x != 2 || y != 2 {
+		t.Errorf("waiting teammate at (%d,%d), want pushed in to (2,2)", x, y)
+	}
+	if m.CurrentState != Playing {
+		t.Errorf("state %v, want the match to carry on", m.CurrentState)
+	}
+}
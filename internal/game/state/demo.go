@@ -0,0 +1,73 @@
+// internal/game/state/demo.go
+package state
+
+import (
+	"github.com/JacobCromwell/Mazenasium/internal/game/maze"
+	"github.com/JacobCromwell/Mazenasium/internal/game/player"
+)
+
+// DemoIdleFrames is how long the menu must sit idle before the demo starts
+const DemoIdleFrames = 30 * 60 // 30 seconds at 60 FPS
+
+// MoveSource supplies the next movement direction for the player
+type MoveSource interface {
+	NextMove() (dx, dy int)
+}
+
+// DemoDriver plays the game on the player's behalf, always following
+// the shortest path to the goal like an optimal NPC would
+type DemoDriver struct {
+	Active     bool
+	IdleFrames int // Frames since the last input on the menu
+
+	maze   *maze.Maze
+	player *player.Player
+}
+
+// NewDemoDriver creates an inactive demo driver
+func NewDemoDriver() *DemoDriver {
+	return &DemoDriver{}
+}
+
+// TrackIdle counts idle frames on the menu and resets on any input
+// Returns true once the menu has been idle long enough to start the demo
+func (d *DemoDriver) TrackIdle(inputReceived bool) bool {
+	if inputReceived {
+		d.IdleFrames = 0
+		return false
+	}
+
+	d.IdleFrames++
+	return d.IdleFrames >= DemoIdleFrames
+}
+
+// Start hands control of the player to the demo driver
+func (d *DemoDriver) Start(mazeObj *maze.Maze, playerObj *player.Player) {
+	d.Active = true
+	d.IdleFrames = 0
+	d.maze = mazeObj
+	d.player = playerObj
+}
+
+// Stop returns control of the player to the keyboard
+func (d *DemoDriver) Stop() {
+	d.Active = false
+	d.IdleFrames = 0
+	d.maze = nil
+	d.player = nil
+}
+
+// NextMove returns the direction of the next step toward the goal
+func (d *DemoDriver) NextMove() (int, int) {
+	if !d.Active || d.maze == nil || d.player == nil {
+		return 0, 0
+	}
+
+	gridX, gridY := d.player.GetGridPosition()
+	next, ok := d.maze.NextStepToGoal(gridX, gridY)
+	if !ok {
+		return 0, 0
+	}
+
+	return next.X - gridX, next.Y - gridY
+}
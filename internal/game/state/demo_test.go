@@ -0,0 +1,72 @@
+// internal/game/state/demo_test.go
+package state
+
+import (
+	"testing"
+
+	"github.com/JacobCromwell/Mazenasium/internal/game/config"
+)
+
+func TestIdleTimerTriggersDemo(t *testing.T) {
+	d := NewDemoDriver()
+	for frame := 1; frame < DemoIdleFrames; frame++ {
+		if d.TrackIdle(false) {
+			t.Fatalf("demo triggered after only %d idle frames", frame)
+		}
+	}
+	if !d.TrackIdle(false) {
+		t.Errorf("demo not triggered after %d idle frames", DemoIdleFrames)
+	}
+}
+
+func TestInputResetsIdleTimer(t *testing.T) {
+	d := NewDemoDriver()
+	for frame := 1; frame < DemoIdleFrames; frame++ {
+		d.TrackIdle(false)
+	}
+	if d.TrackIdle(true) {
+		t.Fatal("input should never trigger the demo")
+	}
+	if d.IdleFrames != 0 {
+		t.Errorf("IdleFrames = %d after input, want 0", d.IdleFrames)
+	}
+	if d.TrackIdle(false) {
+		t.Error("the idle count should start over after input")
+	}
+}
+
+func TestDemoEntryAndExit(t *testing.T) {
+	m, _ := newTestManager(t, config.Default())
+
+	m.startDemo()
+	if !m.Demo.Active || m.CurrentState != Playing {
+		t.Fatalf("after startDemo: active %v, state %v", m.Demo.Active, m.CurrentState)
+	}
+	if m.moveSource() != MoveSource(m.Demo) {
+		t.Error("the demo driver should control the player")
+	}
+
+	m.stopDemo()
+	if m.Demo.Active || m.CurrentState != Menu {
+		t.Errorf("after stopDemo: active %v, state %v", m.Demo.Active, m.CurrentState)
+	}
+	if m.moveSource() != MoveSource(m.InputHandler) {
+		t.Error("the keyboard should control the player again")
+	}
+}
+
+func TestDemoDriverFollowsShortestPath(t *testing.T) {
+	m, _ := newTestManager(t, config.Default())
+	m.startDemo()
+
+	x, y := m.Player.GetGridPosition()
+	next, ok := m.Maze.NextStepToGoal(x, y)
+	if !ok {
+		t.Fatal("the generated maze should be solvable")
+	}
+
+	dx, dy := m.Demo.NextMove()
+	if x+dx != next.X || y+dy != next.Y {
+		t.Errorf("demo steps to (%d,%d), want (%d,%d)", x+dx, y+dy, next.X, next.Y)
+	}
+}
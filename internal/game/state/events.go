@@ -0,0 +1,32 @@
+// internal/game/state/events.go
+package state
+
+import (
+	"github.com/JacobCromwell/Mazenasium/internal/game/eventlog"
+	"github.com/JacobCromwell/Mazenasium/internal/game/events"
+	"github.com/JacobCromwell/Mazenasium/internal/game/ui"
+)
+
+// subscribeEvents hooks the event log and UI messages up to the bus.
+// Handlers only capture the subsystems they use, never the manager itself,
+// because reset replaces the manager's contents with a fresh copy
+func subscribeEvents(bus *events.Bus, eventLog *eventlog.Log, renderer *ui.Renderer) {
+	bus.Subscribe(events.ActionUsed, func(e events.Event) {
+		renderer.ShowMessage(e.Action+" Used!", 1, ui.SuccessMessage)
+		eventLog.Add(e.Turn, e.Action+" used")
+	})
+
+	bus.Subscribe(events.PlayerMoved, func(e events.Event) {
+		eventLog.Add(e.Turn, "Player moved")
+	})
+
+	bus.Subscribe(events.GameWon, func(e events.Event) {
+		eventLog.Add(e.Turn, e.Winner+" won")
+	})
+}
+
+// publish sends an event stamped with the current turn number
+func (m *Manager) publish(event events.Event) {
+	event.Turn = m.TurnManager.TurnNumber
+	m.Events.Publish(event)
+}
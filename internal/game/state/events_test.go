@@ -0,0 +1,30 @@
+// internal/game/state/events_test.go
+package state
+
+import (
+	"testing"
+
+	"github.com/JacobCromwell/Mazenasium/internal/game/action"
+	"github.com/JacobCromwell/Mazenasium/internal/game/config"
+	"github.com/JacobCromwell/Mazenasium/internal/game/events"
+)
+
+func TestUsingAnActionNotifiesSubscribers(t *testing.T) {
+	m, _ := newTestManager(t, config.Default())
+	m.startMatch()
+	m.TurnManager.TurnNumber = 4
+
+	var got []events.Event
+	m.Events.Subscribe(events.ActionUsed, func(e events.Event) { got = append(got, e) })
+	m.useAction(action.Swap)
+
+	if len(got) != 1 || got[0].Action != "Swap" || got[0].Turn != 4 {
+		t.Fatalf("ActionUsed events %+v, want one for Swap on turn 4", got)
+	}
+
+	// The built-in subscribers log the action without being polled
+	entries := m.EventLog.Entries()
+	if len(entries) == 0 || entries[len(entries)-1].Message != "Swap used" {
+		t.Errorf("event log %v should end with the swap", entries)
+	}
+}
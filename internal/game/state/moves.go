@@ -0,0 +1,58 @@
+// internal/game/state/moves.go
+package state
+
+import (
+	"github.com/JacobCromwell/Mazenasium/internal/game/player"
+	"github.com/JacobCromwell/Mazenasium/internal/game/turn"
+)
+
+// movesPerTurn returns the player's step budget for a turn, at least one
+func (m *Manager) movesPerTurn() int {
+	moves := m.Config.PlayerMovesPerTurn
+	if moves < 1 {
+		return 1
+	}
+	if moves > player.MaxMovesPerTurn {
+		return player.MaxMovesPerTurn
+	}
+	return moves
+}
+
+// MovesLeft returns how many steps the player may still take this turn
+func (m *Manager) MovesLeft() int {
+	return m.movesLeft
+}
+
+// resetMoves gives the player their full step budget for a new turn
+func (m *Manager) resetMoves() {
+	m.movesLeft = m.movesPerTurn()
+	m.UIRenderer.MovesLeft, m.UIRenderer.MoveBudget = m.movesLeft, m.movesPerTurn()
+}
+
+// spendMove counts a finished step against the player's budget
+func (m *Manager) spendMove() {
+	if m.movesLeft > 0 {
+		m.movesLeft--
+	}
+	m.UIRenderer.MovesLeft = m.movesLeft
+}
+
+// stopMoving gives up the rest of the player's steps this turn
+func (m *Manager) stopMoving() {
+	m.movesLeft = 0
+	m.UIRenderer.MovesLeft = 0
+	m.TurnManager.NextState(m.afterMoveState())
+}
+
+// afterMoveState is where the player's turn goes once a step is over:
+// back to moving while steps are left, otherwise on to the action phase,
+// or straight to ending the turn if a wrong answer forfeited the action
+func (m *Manager) afterMoveState() turn.State {
+	if m.movesLeft > 0 {
+		return turn.WaitingForMove
+	}
+	if m.actionForfeited {
+		return turn.WaitingForEndTurn
+	}
+	return turn.WaitingForAction
+}
@@ -0,0 +1,58 @@
+// internal/game/state/moves_test.go
+package state
+
+import (
+	"testing"
+
+	"github.com/JacobCromwell/Mazenasium/internal/game/config"
+	"github.com/JacobCromwell/Mazenasium/internal/game/turn"
+)
+
+func TestTwoMoveBudgetAllowsTwoSteps(t *testing.T) {
+	cfg := config.Default()
+	cfg.PlayerMovesPerTurn = 2
+	cfg.TriviaFrequency = 0
+	m, _ := newTestManager(t, cfg)
+	m.startMatch()
+	useGrid(m,
+		"#######",
+		"#....G#",
+		"#######",
+	)
+	m.Player.Teleport(1, 1, m.Maze.GetTileSize())
+	m.Player.Instant = true
+	m.TurnManager.NextState(turn.WaitingForMove)
+	if m.MovesLeft() != 2 {
+		t.Fatalf("%d moves at the start of the turn, want 2", m.MovesLeft())
+	}
+
+	if !m.movePlayer(1, 0) {
+		t.Fatal("the first step was refused")
+	}
+	if m.MovesLeft() != 1 || m.TurnManager.CurrentState != turn.WaitingForMove {
+		t.Fatalf("after one step: %d moves left, turn %v, want another move", m.MovesLeft(), m.TurnManager.CurrentState)
+	}
+
+	if !m.movePlayer(1, 0) {
+		t.Fatal("the second step was refused")
+	}
+	if m.MovesLeft() != 0 || m.TurnManager.CurrentState != turn.WaitingForAction {
+		t.Errorf("after two steps: %d moves left, turn %v, want the action phase", m.MovesLeft(), m.TurnManager.CurrentState)
+	}
+	if x, _ := m.Player.GetGridPosition(); x != 3 {
+		t.Errorf("player at x=%d after two steps, want 3", x)
+	}
+}
+
+func TestStopMovingSkipsTheRestOfTheBudget(t *testing.T) {
+	cfg := config.Default()
+	cfg.PlayerMovesPerTurn = 3
+	m, _ := newTestManager(t, cfg)
+	m.startMatch()
+	m.TurnManager.NextState(turn.WaitingForMove)
+
+	m.stopMoving()
+	if m.MovesLeft() != 0 || m.TurnManager.CurrentState != turn.WaitingForAction {
+		t.Errorf("%d moves left, turn %v, want the action phase", m.MovesLeft(), m.TurnManager.CurrentState)
+	}
+}
@@ -0,0 +1,64 @@
+// internal/game/state/penalty.go
+package state
+
+import (
+	"github.com/JacobCromwell/Mazenasium/internal/game/maze"
+	"github.com/JacobCromwell/Mazenasium/internal/game/trivia"
+	"github.com/JacobCromwell/Mazenasium/internal/game/ui"
+)
+
+// leaveTrivia returns to play once the trivia result has been seen,
+// applying the wrong answer penalty if the player got it wrong
+func (m *Manager) leaveTrivia() {
+	m.CurrentState = Playing
+	if m.checkTargetScore() {
+		return
+	}
+	if m.TriviaMgr.Answered && !m.TriviaMgr.Correct {
+		if m.applyWrongAnswerPenalty(m.Config.WrongAnswerPenalty) {
+			return
+		}
+	}
+	m.TurnManager.NextState(m.afterMoveState())
+}
+
+// applyWrongAnswerPenalty carries out a penalty for a wrong answer.
+// Returns true if the penalty already moved the turn on
+func (m *Manager) applyWrongAnswerPenalty(penalty trivia.WrongAnswerPenalty) bool {
+	switch penalty {
+	case trivia.ForfeitAction:
+		m.actionForfeited = true
+		m.UIRenderer.ShowMessage("Wrong answer - no action this turn", 1.5, ui.WarningMessage)
+		m.Log("Action forfeited")
+
+	case trivia.PushBack:
+		if m.pushBack() {
+			m.UIRenderer.ShowMessage("Wrong answer - pushed back", 1.5, ui.WarningMessage)
+			m.Log("Player pushed back")
+		}
+
+	case trivia.SkipTurn:
+		m.movesLeft = 0
+		m.UIRenderer.MovesLeft = 0
+		m.UIRenderer.ShowMessage("Wrong answer - turn skipped", 1.5, ui.WarningMessage)
+		m.Log("Turn skipped")
+		m.endPlayerTurn()
+		return true
+	}
+	return false
+}
+
+// pushBack returns the player to the tile they stepped from, if it can
+// still be stood on. Returns false if the player stayed put
+func (m *Manager) pushBack() bool {
+	playerGridX, playerGridY := m.Player.GetGridPosition()
+	from := m.previousPos
+	if from == (maze.Position{X: playerGridX, Y: playerGridY}) || !m.Maze.IsValidMove(from.X, from.Y) {
+		return false
+	}
+
+	m.Player.Teleport(from.X, from.Y, m.Maze.GetTileSize())
+	m.recordRoute(from.X, from.Y)
+	m.AnimationMgr.Play(m.UIRenderer.NewCellPulse(m.Maze, from))
+	return true
+}
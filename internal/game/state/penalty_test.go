@@ -0,0 +1,66 @@
+// internal/game/state/penalty_test.go
+package state
+
+import (
+	"testing"
+
+	"github.com/JacobCromwell/Mazenasium/internal/game/config"
+	"github.com/JacobCromwell/Mazenasium/internal/game/trivia"
+	"github.com/JacobCromwell/Mazenasium/internal/game/turn"
+)
+
+func TestWrongAnswerPenalties(t *testing.T) {
+	tests := []struct {
+		penalty    trivia.WrongAnswerPenalty
+		wantX      int
+		wantTurn   turn.State
+		playerTurn bool
+	}{
+		{trivia.NoPenalty, 2, turn.WaitingForAction, true},
+		{trivia.ForfeitAction, 2, turn.WaitingForEndTurn, true},
+		{trivia.PushBack, 1, turn.WaitingForAction, true},
+		{trivia.SkipTurn, 2, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.penalty.String(), func(t *testing.T) {
+			cfg := config.Default()
+			cfg.TriviaFrequency = 0
+			cfg.WrongAnswerPenalty = tt.penalty
+			m, _ := newTestManager(t, cfg)
+			m.startMatch()
+			useGrid(m,
+				"#########",
+				"#......G#",
+				"#########",
+			)
+			m.Player.Teleport(1, 1, m.Maze.GetTileSize())
+			m.Player.Instant = true
+			m.NPCManager.NPCs = m.NPCManager.NPCs[:1]
+			m.NPCManager.NPCs[0].Teleport(6, 1)
+			m.TurnManager.NextState(turn.WaitingForMove)
+			if !m.movePlayer(1, 0) {
+				t.Fatal("the step was refused")
+			}
+
+			// A wrong answer to the question asked on arrival
+			m.CurrentState = AnsweringTrivia
+			m.TurnManager.NextState(turn.WaitingForTrivia)
+			m.TriviaMgr.Answered, m.TriviaMgr.Correct = true, false
+			m.leaveTrivia()
+
+			if m.CurrentState != Playing {
+				t.Errorf("state %v, want Playing", m.CurrentState)
+			}
+			if x, _ := m.Player.GetGridPosition(); x != tt.wantX {
+				t.Errorf("player at x=%d, want %d", x, tt.wantX)
+			}
+			if m.TurnManager.IsPlayerTurn() != tt.playerTurn {
+				t.Fatalf("player's turn = %v, want %v", m.TurnManager.IsPlayerTurn(), tt.playerTurn)
+			}
+			if tt.playerTurn && m.TurnManager.CurrentState != tt.wantTurn {
+				t.Errorf("turn state %v, want %v", m.TurnManager.CurrentState, tt.wantTurn)
+			}
+		})
+	}
+}
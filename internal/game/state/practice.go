@@ -0,0 +1,23 @@
+// internal/game/state/practice.go
+package state
+
+import (
+	"github.com/JacobCromwell/Mazenasium/internal/game/action"
+	"github.com/JacobCromwell/Mazenasium/internal/game/npc"
+)
+
+// startPractice begins a fresh match for learning the controls: no NPCs,
+// no trivia and actions that can be used as often as the player likes.
+// The settings in Config are left alone so regular matches are unaffected
+func (m *Manager) startPractice() {
+	m.reset()
+	m.Practice = true
+
+	// An empty NPC manager hands every NPC phase straight back to the player
+	m.NPCManager = npc.NewManagerWithSeed(m.Maze.Generator.RandomSeed)
+	m.ActionMgr.SetMode(action.UnlimitedMode)
+
+	m.CurrentState = Playing
+	m.matchStart = m.Clock.Now()
+	m.UIRenderer.SetActionMessage("Practice - no NPCs, no limits", 120)
+}
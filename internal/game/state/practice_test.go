@@ -0,0 +1,45 @@
+// internal/game/state/practice_test.go
+package state
+
+import (
+	"testing"
+
+	"github.com/JacobCromwell/Mazenasium/internal/game/action"
+	"github.com/JacobCromwell/Mazenasium/internal/game/config"
+	"github.com/JacobCromwell/Mazenasium/internal/game/turn"
+)
+
+func TestPracticeTurnsComeStraightBack(t *testing.T) {
+	m, _ := newTestManager(t, config.Default())
+	m.startPractice()
+	if len(m.NPCManager.NPCs) != 0 {
+		t.Fatalf("practice spawned %d NPCs", len(m.NPCManager.NPCs))
+	}
+
+	for round := 1; round <= 3; round++ {
+		m.TurnManager.NextState(turn.WaitingForEndTurn)
+		m.endPlayerTurn()
+
+		// The empty NPC phase has to hand the turn back within a frame or two
+		for frame := 0; frame < 5 && !m.TurnManager.IsPlayerTurn(); frame++ {
+			m.processNPCTurn()
+		}
+		if !m.TurnManager.IsPlayerTurn() || m.TurnManager.CurrentState != turn.WaitingForMove {
+			t.Fatalf("round %d: owner %v state %v, want the player's move again", round, m.TurnManager.CurrentOwner, m.TurnManager.CurrentState)
+		}
+		if m.TurnManager.TurnNumber != round+1 {
+			t.Errorf("round %d: turn number %d, want %d", round, m.TurnManager.TurnNumber, round+1)
+		}
+	}
+}
+
+func TestPracticeActionsNeverRunOut(t *testing.T) {
+	m, _ := newTestManager(t, config.Default())
+	m.startPractice()
+	for use := 0; use < 10; use++ {
+		if !m.ActionMgr.IsActionAvailable(action.PeekGoal) {
+			t.Fatalf("Peek Goal unavailable after %d uses in practice", use)
+		}
+		m.useAction(action.PeekGoal)
+	}
+}
@@ -0,0 +1,25 @@
+// internal/game/state/route.go
+package state
+
+import (
+	"github.com/JacobCromwell/Mazenasium/internal/game/maze"
+)
+
+// recordRoute adds the cell the player has just arrived on to their route.
+// Standing still, such as a push back that went nowhere, adds nothing
+func (m *Manager) recordRoute(x, y int) {
+	cell := maze.Position{X: x, Y: y}
+	if n := len(m.route); n > 0 && m.route[n-1] == cell {
+		return
+	}
+	m.route = append(m.route, cell)
+}
+
+// Route returns every cell the player has arrived on this match, in order,
+// starting with the start cell. Teleports, traps and other jumps show up as
+// consecutive cells that aren't neighbours
+func (m *Manager) Route() []maze.Position {
+	route := make([]maze.Position, len(m.route))
+	copy(route, m.route)
+	return route
+}
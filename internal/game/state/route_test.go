@@ -0,0 +1,65 @@
+// internal/game/state/route_test.go
+package state
+
+import (
+	"testing"
+
+	"github.com/JacobCromwell/Mazenasium/internal/game/config"
+	"github.com/JacobCromwell/Mazenasium/internal/game/maze"
+)
+
+func TestRouteFollowsTheArrivals(t *testing.T) {
+	cfg := config.Default()
+	cfg.TriviaFrequency = 0
+	m, _ := newTestManager(t, cfg)
+	m.startMatch()
+	useGrid(m,
+		"#########",
+		"#......G#",
+		"#########",
+	)
+	m.Maze.State.AddTeleporterPair(maze.Position{X: 2, Y: 1}, maze.Position{X: 5, Y: 1})
+	m.Player.Teleport(1, 1, m.Maze.GetTileSize())
+	m.Player.Instant = true
+	m.route = []maze.Position{{X: 1, Y: 1}}
+	m.NPCManager.NPCs = nil
+
+	arrivals := []maze.Position{{X: 1, Y: 1}}
+	for m.CurrentState == Playing && len(arrivals) < 10 {
+		if !m.movePlayer(1, 0) {
+			t.Fatalf("the step from %v was refused", arrivals[len(arrivals)-1])
+		}
+		x, y := m.Player.GetGridPosition()
+		arrivals = append(arrivals, maze.Position{X: x, Y: y})
+	}
+	if m.CurrentState != GameOver {
+		t.Fatal("the player never reached the goal")
+	}
+
+	// The teleporter is arrived on before the hop to its partner
+	want := []maze.Position{{X: 1, Y: 1}, {X: 2, Y: 1}, {X: 5, Y: 1}, {X: 6, Y: 1}, {X: 7, Y: 1}}
+	route := m.GameOverInfo().Route
+	if len(route) != len(want) {
+		t.Fatalf("route %v, want %v", route, want)
+	}
+	for i := range want {
+		if route[i] != want[i] {
+			t.Errorf("route step %d = %v, want %v", i, route[i], want[i])
+		}
+	}
+	if last := arrivals[len(arrivals)-1]; route[len(route)-1] != last {
+		t.Errorf("route ends on %v, the player finished on %v", route[len(route)-1], last)
+	}
+}
+
+func TestRouteSkipsStandingStill(t *testing.T) {
+	m, _ := newTestManager(t, config.Default())
+	m.route = nil
+	m.recordRoute(3, 4)
+	m.recordRoute(3, 4)
+	m.recordRoute(4, 4)
+
+	if route := m.Route(); len(route) != 2 {
+		t.Errorf("route %v, want the repeated cell recorded once", route)
+	}
+}
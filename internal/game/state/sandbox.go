@@ -0,0 +1,69 @@
+// internal/game/state/sandbox.go
+package state
+
+import (
+	"github.com/JacobCromwell/Mazenasium/internal/game/maze"
+	"github.com/JacobCromwell/Mazenasium/internal/game/npc"
+)
+
+// SandboxNPCCount is how many NPCs race each other in the sandbox
+const SandboxNPCCount = 4
+
+// sandboxShapes cycle alongside the colors
+var sandboxShapes = []npc.Shape{npc.Square, npc.Circle, npc.Triangle}
+
+// startSandbox begins a fresh match where optimal NPCs race to the goal
+// with no human player
+func (m *Manager) startSandbox() {
+	m.reset()
+	m.Sandbox = true
+	m.CurrentState = Playing
+	m.matchStart = m.Clock.Now()
+	m.UIRenderer.HidePlayer = true
+
+	// Replace the regular NPCs with racers that never hinder each other
+	m.NPCManager = npc.NewManagerWithSeed(m.Maze.Generator.RandomSeed)
+	m.NPCManager.RotateChance = 0
+	tileSize := m.Maze.GetTileSize()
+	for i, spawn := range m.sandboxSpawns() {
+		racer := npc.New(i, spawn.X, spawn.Y, tileSize, npc.PaletteColor(m.Config.NPCPalette, i))
+		racer.Strategy = npc.Optimal
+		racer.Shape = sandboxShapes[i%len(sandboxShapes)]
+		racer.Easing = m.Config.MoveEasing
+		racer.Instant = m.Config.InstantMovement
+		m.NPCManager.AddNPC(racer)
+	}
+
+	m.TurnManager.StartNPCOnly()
+	m.UIRenderer.SetActionMessage("Sandbox - Esc to leave", 120)
+}
+
+// sandboxSpawns picks a distinct floor cell for each racer, starting with
+// the player's start and the usual NPC spawns, then the far corners
+func (m *Manager) sandboxSpawns() []maze.Position {
+	state := m.Maze.State
+	requests := append([]maze.Position{m.Maze.StartPosition()}, m.Maze.SpawnPositions()...)
+	requests = append(requests,
+		maze.Position{X: state.Width - 2, Y: 1},
+		maze.Position{X: 1, Y: state.Height - 2},
+	)
+
+	spawns := []maze.Position{}
+	taken := make(map[maze.Position]bool)
+	for _, request := range requests {
+		if len(spawns) == SandboxNPCCount {
+			break
+		}
+		spawn, ok := state.NearestFloor(request, taken)
+		if !ok {
+			continue
+		}
+		taken[spawn] = true
+
+		// Starting on the goal would end the race before it began
+		if !state.GetTile(spawn.X, spawn.Y).IsGoal() {
+			spawns = append(spawns, spawn)
+		}
+	}
+	return spawns
+}
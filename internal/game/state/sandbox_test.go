@@ -0,0 +1,38 @@
+// internal/game/state/sandbox_test.go
+package state
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/JacobCromwell/Mazenasium/internal/game/config"
+	"github.com/JacobCromwell/Mazenasium/internal/game/ui"
+)
+
+func TestSandboxEndsWithWinner(t *testing.T) {
+	for _, seed := range []int64{1, 2, 3} {
+		cfg := config.Default()
+		cfg.Seed = seed
+		cfg.InstantMovement = true
+		m, _ := newTestManager(t, cfg)
+
+		m.startSandbox()
+		if !m.Maze.IsSolvable() {
+			t.Fatalf("seed %d: the sandbox maze should be solvable", seed)
+		}
+		if len(m.NPCManager.NPCs) < 2 {
+			t.Fatalf("seed %d: only %d racers, want a race", seed, len(m.NPCManager.NPCs))
+		}
+
+		for frame := 0; frame < 10000 && m.CurrentState == Playing; frame++ {
+			m.updatePlaying()
+		}
+
+		if m.CurrentState != GameOver {
+			t.Fatalf("seed %d: sandbox still running, state %v", seed, m.CurrentState)
+		}
+		if !strings.HasPrefix(m.Winner, "NPC") || m.WinReason != ui.ReachedGoal {
+			t.Errorf("seed %d: winner = %q reason %v, want one of the racers at the goal", seed, m.Winner, m.WinReason)
+		}
+	}
+}
@@ -0,0 +1,207 @@
+// internal/game/state/save.go
+package state
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/JacobCromwell/Mazenasium/internal/game/action"
+	"github.com/JacobCromwell/Mazenasium/internal/game/config"
+	"github.com/JacobCromwell/Mazenasium/internal/game/events"
+	"github.com/JacobCromwell/Mazenasium/internal/game/maze"
+	"github.com/JacobCromwell/Mazenasium/internal/game/trivia"
+	"github.com/JacobCromwell/Mazenasium/internal/game/turn"
+	"github.com/JacobCromwell/Mazenasium/internal/game/ui"
+)
+
+// DefaultSavePath is the slot auto-saves are written to and Continue loads
+const DefaultSavePath = "autosave.json"
+
+// SaveGame is a match in progress as plain data, enough to carry on from
+// where it was saved
+type SaveGame struct {
+	Config config.Config   `json:"config"`
+	Seed   int64           `json:"seed"` // Seed the maze was generated from
+	Maze   maze.SavedState `json:"maze"`
+
+	Player   maze.Position   `json:"player"`
+	NPCs     []maze.Position `json:"npcs"` // Cell of each NPC, by ID
+	Turn     int             `json:"turn"`
+	NPCPhase bool            `json:"npcPhase"` // Saved as the NPCs were about to move
+
+	Score   int             `json:"score"`
+	Stats   MatchStats      `json:"stats"`
+	Actions action.Snapshot `json:"actions"`
+	Trivia  []trivia.Result `json:"trivia"`
+	Route   []maze.Position `json:"route"`
+
+	FloorTiles       int `json:"floorTiles"`
+	ExploredTiles    int `json:"exploredTiles"`
+	MovesSinceTrivia int `json:"movesSinceTrivia"`
+}
+
+// WriteSave writes a saved game to path. The file is written in full to a
+// temporary file first and then moved over path, so a failed write never
+// leaves a half-written save behind
+func WriteSave(path string, save SaveGame) error {
+	data, err := json.MarshalIndent(save, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode saved game: %v", err)
+	}
+
+	temp := path + ".tmp"
+	if err := os.WriteFile(temp, data, 0644); err != nil {
+		os.Remove(temp)
+		return fmt.Errorf("failed to write saved game: %v", err)
+	}
+	if err := os.Rename(temp, path); err != nil {
+		os.Remove(temp)
+		return fmt.Errorf("failed to replace saved game: %v", err)
+	}
+
+	return nil
+}
+
+// ReadSave reads a saved game written by WriteSave
+func ReadSave(path string) (SaveGame, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return SaveGame{}, fmt.Errorf("failed to read saved game: %v", err)
+	}
+
+	var save SaveGame
+	if err := json.Unmarshal(data, &save); err != nil {
+		return SaveGame{}, fmt.Errorf("failed to parse saved game: %v", err)
+	}
+
+	return save, nil
+}
+
+// saveExists checks if there is a saved game to continue
+func saveExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// autoSaving checks if this match is saved between turns. Demos, practice
+// and the sandbox never touch the save slot, and a save only holds one
+// human, so co-op matches aren't saved either
+func (m *Manager) autoSaving() bool {
+	return m.Config.AutoSave && !m.Demo.Active && !m.Practice && !m.Sandbox && !m.coop()
+}
+
+// snapshotGame captures the match in progress
+func (m *Manager) snapshotGame() SaveGame {
+	playerGridX, playerGridY := m.Player.GetGridPosition()
+	save := SaveGame{
+		Config:   m.Config,
+		Seed:     m.Maze.Generator.RandomSeed,
+		Maze:     m.Maze.State.Save(),
+		Player:   maze.Position{X: playerGridX, Y: playerGridY},
+		Turn:     m.TurnManager.TurnNumber,
+		NPCPhase: m.TurnManager.CurrentOwner == turn.NPCTurn,
+
+		Score:   m.Score.Points,
+		Stats:   *m.Stats,
+		Actions: m.ActionMgr.Snapshot(),
+		Trivia:  m.TriviaMgr.SessionSummary(),
+		Route:   m.Route(),
+
+		FloorTiles:       m.floorTiles,
+		ExploredTiles:    m.exploredTiles,
+		MovesSinceTrivia: m.movesSinceTrivia,
+	}
+	save.Stats.Elapsed = m.Clock.Since(m.matchStart)
+	for _, n := range m.NPCManager.NPCs {
+		save.NPCs = append(save.NPCs, maze.Position{X: n.GridX, Y: n.GridY})
+	}
+	return save
+}
+
+// autoSave writes the match to the save slot between turns
+func (m *Manager) autoSave() {
+	if !m.autoSaving() {
+		return
+	}
+	if err := WriteSave(DefaultSavePath, m.snapshotGame()); err != nil {
+		m.Logger.Warn("failed to auto-save", "err", err)
+	}
+}
+
+// clearSave removes the save slot once the match saved in it is over,
+// so Continue never offers a finished match
+func (m *Manager) clearSave() {
+	if !m.autoSaving() {
+		return
+	}
+	if err := os.Remove(DefaultSavePath); err != nil && !os.IsNotExist(err) {
+		m.Logger.Warn("failed to remove saved game", "err", err)
+	}
+}
+
+// continueGame loads the save slot and carries on with the match in it
+func (m *Manager) continueGame() {
+	save, err := ReadSave(DefaultSavePath)
+	if err == nil {
+		err = m.restoreGame(save)
+	}
+	if err != nil {
+		m.Logger.Warn("failed to continue saved game", "err", err)
+		m.UIRenderer.ShowMessage("Couldn't load the saved game", 2, ui.ErrorMessage)
+	}
+}
+
+// restoreGame rebuilds the manager around a saved match and starts playing it
+func (m *Manager) restoreGame(save SaveGame) error {
+	grid, err := maze.LoadState(save.Maze)
+	if err != nil {
+		return err
+	}
+
+	// Regenerate from the saved seed so the maze's generator and NPCs match,
+	// then put the saved grid in place of the fresh one
+	cfg := save.Config
+	m.Config = cfg
+	m.Config.Seed = save.Seed
+	m.reset()
+	m.Config.Seed = cfg.Seed
+	m.applyConfig()
+	m.Maze.State = grid
+
+	tileSize := m.Maze.GetTileSize()
+	m.Player.Teleport(save.Player.X, save.Player.Y, tileSize)
+	for i, n := range m.NPCManager.NPCs {
+		if i < len(save.NPCs) {
+			n.Teleport(save.NPCs[i].X, save.NPCs[i].Y)
+		}
+	}
+
+	m.startMatch()
+	m.matchStart = m.Clock.Now().Add(-save.Stats.Elapsed)
+	m.TurnManager.TurnNumber = save.Turn
+	m.Score.Points = save.Score
+	m.UIRenderer.Score = save.Score
+	*m.Stats = save.Stats
+	if m.Stats.ActionsUsed == nil {
+		m.Stats.ActionsUsed = make(map[action.ActionType]int)
+	}
+	m.ActionMgr.Restore(save.Actions)
+	m.TriviaMgr.RestoreSession(save.Trivia)
+	m.route = append([]maze.Position(nil), save.Route...)
+	m.floorTiles = save.FloorTiles
+	m.exploredTiles = save.ExploredTiles
+	m.UIRenderer.ExploredPercent = m.ExploredPercent()
+	m.movesSinceTrivia = save.MovesSinceTrivia
+
+	// Pick up in the phase the match was saved in
+	if save.NPCPhase {
+		m.TurnManager.EndTurn()
+		m.publish(events.Event{Type: events.TurnChanged, PlayerTurn: false})
+		m.NPCManager.ResetMovedStatus()
+	} else {
+		m.beginPlayerTurn()
+	}
+	m.Log(fmt.Sprintf("Continued saved game from turn %d", save.Turn))
+	return nil
+}
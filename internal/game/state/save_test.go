@@ -0,0 +1,75 @@
+// internal/game/state/save_test.go
+package state
+
+import (
+	"testing"
+
+	"github.com/JacobCromwell/Mazenasium/internal/game/config"
+	"github.com/JacobCromwell/Mazenasium/internal/game/logging"
+	"github.com/JacobCromwell/Mazenasium/internal/game/turn"
+)
+
+func TestAutoSaveAfterTurnAndContinue(t *testing.T) {
+	cfg := config.Default()
+	cfg.AutoSave = true
+	cfg.InstantMovement = true
+	cfg.TriviaFrequency = 0
+	m, _ := newTestManager(t, cfg)
+	m.startMatch()
+	if saveExists(DefaultSavePath) {
+		t.Fatal("a save was written before any turn ended")
+	}
+
+	m.TurnManager.NextState(turn.WaitingForMove)
+	dx, dy := openNeighbour(t, m)
+	m.movePlayer(dx, dy)
+	m.Score.Add(20)
+	m.endPlayerTurn()
+	if !saveExists(DefaultSavePath) {
+		t.Fatal("no auto-save after the turn ended")
+	}
+	playerX, playerY := m.Player.GetGridPosition()
+	grid := gridTypes(m)
+
+	// A fresh game in the same directory offers Continue and picks up there
+	resumed := NewWithConfig(config.Default())
+	resumed.Logger = logging.Nop{}
+	resumed.Flavor.Logger = logging.Nop{}
+	offered := false
+	for _, item := range resumed.MenuMgr.RootMenu.Items {
+		offered = offered || (item.Action == "continue_game" && !item.Hidden)
+	}
+	if !offered {
+		t.Fatal("the menu doesn't offer Continue")
+	}
+	resumed.handleMenuAction("continue_game")
+
+	if resumed.CurrentState != Playing {
+		t.Fatalf("state %v after Continue, want Playing", resumed.CurrentState)
+	}
+	if x, y := resumed.Player.GetGridPosition(); x != playerX || y != playerY {
+		t.Errorf("player continued on (%d,%d), saved on (%d,%d)", x, y, playerX, playerY)
+	}
+	if resumed.Score.Points != m.Score.Points || resumed.TurnManager.TurnNumber != m.TurnManager.TurnNumber {
+		t.Errorf("continued with score %d turn %d, saved score %d turn %d",
+			resumed.Score.Points, resumed.TurnManager.TurnNumber, m.Score.Points, m.TurnManager.TurnNumber)
+	}
+	if resumed.TurnManager.CurrentOwner != turn.NPCTurn {
+		t.Error("the match was saved as the NPCs were about to move, but continued on the player's turn")
+	}
+	if !sameGrid(gridTypes(resumed), grid) {
+		t.Error("the continued maze differs from the saved one")
+	}
+}
+
+func TestNoAutoSaveWhenOff(t *testing.T) {
+	cfg := config.Default()
+	cfg.AutoSave = false
+	m, _ := newTestManager(t, cfg)
+	m.startMatch()
+	m.endPlayerTurn()
+
+	if saveExists(DefaultSavePath) {
+		t.Error("a save was written with auto-save off")
+	}
+}
@@ -0,0 +1,40 @@
+// internal/game/state/score_win.go
+package state
+
+import (
+	"fmt"
+
+	"github.com/JacobCromwell/Mazenasium/internal/game/config"
+	"github.com/JacobCromwell/Mazenasium/internal/game/ui"
+)
+
+// targetScoreOptions are the winning scores offered in the customize menu
+var targetScoreOptions = []int{3, config.DefaultTargetScore, 10}
+
+// nextTargetScore returns the winning score after current in the menu cycle
+func nextTargetScore(current int) int {
+	for _, target := range targetScoreOptions {
+		if target > current {
+			return target
+		}
+	}
+	return targetScoreOptions[0]
+}
+
+// scoreRace checks if this match is won by trivia points rather than by
+// reaching the goal. The sandbox has no player, so it always races
+func (m *Manager) scoreRace() bool {
+	return m.Config.WinMode == config.TriviaScore && !m.Sandbox
+}
+
+// checkTargetScore declares the player the winner once their trivia points
+// reach the target. Returns true if the match is over
+func (m *Manager) checkTargetScore() bool {
+	if !m.scoreRace() || m.Demo.Active || m.Score.Points < m.Config.TargetScore {
+		return false
+	}
+
+	m.Log(fmt.Sprintf("Player scored %d points", m.Score.Points))
+	m.finishGame(m.playerWinner(), ui.TargetScore)
+	return true
+}
@@ -0,0 +1,60 @@
+// internal/game/state/score_win_test.go
+package state
+
+import (
+	"testing"
+
+	"github.com/JacobCromwell/Mazenasium/internal/game/config"
+	"github.com/JacobCromwell/Mazenasium/internal/game/turn"
+	"github.com/JacobCromwell/Mazenasium/internal/game/ui"
+)
+
+// newScoreRace starts a trivia score match on a single corridor
+func newScoreRace(t *testing.T) *Manager {
+	t.Helper()
+	cfg := config.Default()
+	cfg.WinMode = config.TriviaScore
+	cfg.TargetScore = 30
+	m, _ := newTestManager(t, cfg)
+	m.startMatch()
+	useGrid(m,
+		"#######",
+		"#....G#",
+		"#######",
+	)
+	m.Player.Teleport(4, 1, m.Maze.GetTileSize())
+	m.Player.Instant = true
+	m.NPCManager.NPCs = nil
+	return m
+}
+
+func TestReachingTheTargetScoreWins(t *testing.T) {
+	m := newScoreRace(t)
+
+	m.Score.Add(m.Config.TargetScore - 1)
+	if m.checkTargetScore() || m.CurrentState != Playing {
+		t.Fatalf("the match ended one point short of the target, state %v", m.CurrentState)
+	}
+
+	// The last point comes from a right answer
+	m.Score.Add(1)
+	m.CurrentState = AnsweringTrivia
+	m.TriviaMgr.Answered, m.TriviaMgr.Correct = true, true
+	m.leaveTrivia()
+	if m.CurrentState != GameOver || m.Winner != "Player" || m.WinReason != ui.TargetScore {
+		t.Errorf("state %v winner %q reason %v at %d points, want the player to win on score", m.CurrentState, m.Winner, m.WinReason, m.Score.Points)
+	}
+}
+
+func TestGoalIsInertInScoreRace(t *testing.T) {
+	m := newScoreRace(t)
+	m.TurnManager.NextState(turn.WaitingForMove)
+
+	if !m.movePlayer(1, 0) {
+		t.Fatal("the step onto the goal was refused")
+	}
+	// Every move asks a question in a score race, the goal doesn't end it
+	if m.CurrentState == GameOver || m.Winner != "" {
+		t.Errorf("state %v winner %q after reaching the goal, want the score race to carry on", m.CurrentState, m.Winner)
+	}
+}
@@ -0,0 +1,76 @@
+// internal/game/state/shrink.go
+package state
+
+import (
+	"fmt"
+
+	"github.com/JacobCromwell/Mazenasium/internal/game/ui"
+)
+
+// shrinkIntervals are the shrinking maze settings offered in the customize
+// menu, as the number of rounds between rings with 0 for never
+var shrinkIntervals = []int{0, 3, 5, 10}
+
+// nextShrinkInterval returns the shrink setting after current in the menu cycle
+func nextShrinkInterval(current int) int {
+	for _, interval := range shrinkIntervals {
+		if interval > current {
+			return interval
+		}
+	}
+	return shrinkIntervals[0]
+}
+
+// formatShrinkInterval formats a shrink setting for display in the menu
+func formatShrinkInterval(rounds int) string {
+	if rounds <= 0 {
+		return "Off"
+	}
+	return fmt.Sprintf("Every %d", rounds)
+}
+
+// shrinkDue checks if the round that just started closes the maze in
+func (m *Manager) shrinkDue() bool {
+	every := m.Config.ShrinkEvery
+	if every <= 0 || m.Sandbox || m.Demo.Active {
+		return false
+	}
+	completed := m.TurnManager.TurnNumber - 1
+	return completed > 0 && completed%every == 0
+}
+
+// shrinkMaze walls the outer ring of the maze, pushing the humans and NPCs
+// caught in it further in. When racing, the match is lost if any human is
+// left with no way to the goal
+func (m *Manager) shrinkMaze() {
+	humans := m.humanPlayers()
+	entities := m.collectEntityPositions()
+
+	placed, ok := m.Maze.ShrinkOneRing(entities)
+	if !ok {
+		return
+	}
+
+	for i, human := range humans {
+		if pos := placed[i]; pos != entities[i] {
+			human.Teleport(pos.X, pos.Y, m.Maze.GetTileSize())
+			if human == m.Player {
+				m.recordRoute(pos.X, pos.Y)
+			}
+		}
+	}
+	for i, n := range m.NPCManager.NPCs {
+		if pos := placed[len(humans)+i]; pos != entities[len(humans)+i] {
+			n.Teleport(pos.X, pos.Y)
+		}
+	}
+
+	m.Log("The maze closed in")
+	m.UIRenderer.Shake.Start(ui.TrapShakeIntensity)
+	m.UIRenderer.ShowMessage("The maze closes in!", 1.5, ui.WarningMessage)
+
+	if m.goalWins() && !m.allReachGoal(placed[:len(humans)]) {
+		m.Log("Player was walled in")
+		m.finishGame("The Maze", ui.WalledIn)
+	}
+}
@@ -0,0 +1,100 @@
+// internal/game/state/shrink_test.go
+package state
+
+import (
+	"testing"
+
+	"github.com/JacobCromwell/Mazenasium/internal/game/config"
+	"github.com/JacobCromwell/Mazenasium/internal/game/maze"
+	"github.com/JacobCromwell/Mazenasium/internal/game/ui"
+)
+
+// newShrinkMatch starts a match on an open 9x9 grid with the goal in the
+// middle, shrinking every three rounds, with the player and one NPC inside
+// the ring that closes first
+func newShrinkMatch(t *testing.T) *Manager {
+	t.Helper()
+	cfg := config.Default()
+	cfg.ShrinkEvery = 3
+	m, _ := newTestManager(t, cfg)
+	m.startMatch()
+	useShrinkGrid(m)
+	m.Player.Teleport(1, 1, m.Maze.GetTileSize())
+	m.NPCManager.NPCs = m.NPCManager.NPCs[:1]
+	m.NPCManager.NPCs[0].Teleport(7, 7)
+	return m
+}
+
+// useShrinkGrid replaces the maze with an open 9x9 grid with the goal in the middle
+func useShrinkGrid(m *Manager) {
+	useGrid(m,
+		"#########",
+		"#.......#",
+		"#.......#",
+		"#.......#",
+		"#...G...#",
+		"#.......#",
+		"#.......#",
+		"#.......#",
+		"#########",
+	)
+}
+
+func TestMazeShrinksEveryIntervalRounds(t *testing.T) {
+	m := newShrinkMatch(t)
+	m.NPCManager.NPCs = nil
+
+	var shrankOn []int
+	for round := 0; round < 7; round++ {
+		border := m.Maze.State.Border
+		m.TurnManager.EndTurn()
+		m.processNPCTurn()
+		if m.Maze.State.Border != border {
+			shrankOn = append(shrankOn, m.TurnManager.TurnNumber)
+		}
+	}
+
+	// Rounds 4 and 7 start once 3 and 6 rounds have been played
+	if len(shrankOn) != 2 || shrankOn[0] != 4 || shrankOn[1] != 7 {
+		t.Errorf("maze shrank as rounds %v started, want 4 and 7", shrankOn)
+	}
+
+	m.Config.ShrinkEvery = 0
+	if m.shrinkDue() {
+		t.Error("a shrink is due with shrinking off")
+	}
+}
+
+func TestShrinkPushesEntitiesInward(t *testing.T) {
+	m := newShrinkMatch(t)
+	hunter := m.NPCManager.NPCs[0]
+
+	m.shrinkMaze()
+
+	if m.Maze.State.Border != 2 {
+		t.Fatalf("border %d after a shrink, want 2", m.Maze.State.Border)
+	}
+	playerX, playerY := m.Player.GetGridPosition()
+	if m.Maze.IsWall(playerX, playerY) || playerX != 2 || playerY != 2 {
+		t.Errorf("player at (%d,%d), want pushed in to (2,2)", playerX, playerY)
+	}
+	if m.Maze.IsWall(hunter.GridX, hunter.GridY) || hunter.GridX != 6 || hunter.GridY != 6 {
+		t.Errorf("NPC at (%d,%d), want pushed in to (6,6)", hunter.GridX, hunter.GridY)
+	}
+	if m.CurrentState != Playing {
+		t.Errorf("state %v, want the match to carry on", m.CurrentState)
+	}
+}
+
+func TestWalledInPlayerLoses(t *testing.T) {
+	m := newShrinkMatch(t)
+
+	// A shrink carves a way back to any goal, so only a maze left with
+	// no goal at all walls the player in
+	m.Maze.State.SetTileType(4, 4, maze.Floor)
+	m.shrinkMaze()
+
+	if m.CurrentState != GameOver || m.Winner != "The Maze" || m.WinReason != ui.WalledIn {
+		t.Errorf("state %v winner %q reason %v, want the maze to wall the player in", m.CurrentState, m.Winner, m.WinReason)
+	}
+}
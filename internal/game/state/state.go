@@ -3,20 +3,39 @@ package state
 
 import (
 	"fmt"
-	"image/color"
+	"strconv"
+	"time"
 	//"math/rand" // skipping trivia for now
 
 	"github.com/JacobCromwell/Mazenasium/internal/game/action"
+	"github.com/JacobCromwell/Mazenasium/internal/game/animation"
+	"github.com/JacobCromwell/Mazenasium/internal/game/clock"
+	"github.com/JacobCromwell/Mazenasium/internal/game/config"
+	"github.com/JacobCromwell/Mazenasium/internal/game/eventlog"
+	"github.com/JacobCromwell/Mazenasium/internal/game/events"
 	"github.com/JacobCromwell/Mazenasium/internal/game/flavor"
+	"github.com/JacobCromwell/Mazenasium/internal/game/highscore"
+	"github.com/JacobCromwell/Mazenasium/internal/game/logging"
 	"github.com/JacobCromwell/Mazenasium/internal/game/maze"
 	"github.com/JacobCromwell/Mazenasium/internal/game/menu"
 	"github.com/JacobCromwell/Mazenasium/internal/game/npc"
 	"github.com/JacobCromwell/Mazenasium/internal/game/player"
+	"github.com/JacobCromwell/Mazenasium/internal/game/score"
 	"github.com/JacobCromwell/Mazenasium/internal/game/trivia"
 	"github.com/JacobCromwell/Mazenasium/internal/game/turn"
 	"github.com/JacobCromwell/Mazenasium/internal/game/ui"
 )
 
+// MoveSpeed is how fast entities slide between cells, in pixels per second
+const MoveSpeed = 300.0
+
+// MaxFrameDelta caps the time a single update can simulate, so a stalled
+// frame doesn't teleport everything across the maze
+const MaxFrameDelta = 0.1
+
+// PeekGoalFrames is how long the peek goal action reveals the goal (3 seconds at 60 FPS)
+const PeekGoalFrames = 180
+
 // GameState represents the current state of the game
 type GameState int
 
@@ -29,6 +48,7 @@ const (
 
 // Manager handles all game state logic
 type Manager struct {
+	Config       config.Config
 	CurrentState GameState
 	TurnManager  *turn.Manager
 	Player       *player.Player
@@ -40,48 +60,186 @@ type Manager struct {
 	UIRenderer   *ui.Renderer
 	InputHandler *ui.InputHandler
 	Flavor       *flavor.Manager
+	AnimationMgr *animation.Manager
+	EventLog     *eventlog.Log
+	Events       *events.Bus
+	Logger       logging.Logger // Where warnings go, logging.Default() when the manager is created
+	Clock        clock.Clock    // Time source for timers and delta time, clock.Real by default
+	Demo         *DemoDriver
+	Sandbox      bool // Spectating NPCs racing each other, no human player
+	Practice     bool // Learning match with no NPCs, trivia or action limits
+	HighScores   *highscore.Table
+	Stats        *MatchStats
+	Score        *score.Keeper
+	NewRecord    bool // The finished run beat the stored best for this maze size
 	Winner       string
+	WinReason    ui.WinReason // How Winner won, for the game over message
+	ShouldExit   bool         // Set once the player confirms quitting; the game loop should stop
+	OnQuit       func()       // Called when the player confirms quitting the game, if set
+
+	matchStart time.Time // When the current match left the menu
+	lastUpdate time.Time // When Update last ran, for delta time
+	frameDelta float64   // Seconds since the previous update
 
 	// fields for xRotateAction
-	xRotateActive    bool // Whether X-rotate mode is active
-	xRotateDirection int  // 1 for right, -1 for left
+	xRotateActive    bool          // Whether X-rotate mode is active
+	xRotateDirection int           // 1 for right, -1 for left
+	lastRotation     *rotationUndo // Rotation that can still be undone this turn
+
+	// fields for shuffleRowAction
+	shuffleActive bool // Whether row shuffle confirmation is active
+
+	// fields for peekGoalAction
+	peekTimer int // Frames left before the goal highlight is cleared
+
+	// fields for the exploration meter
+	floorTiles    int // Walkable tiles in the maze, counted after generation
+	exploredTiles int // Walkable tiles the player has visited
+
+	// fields for the trivia result screen
+	triviaAnsweredAt time.Time // When the current question was answered
+	movesSinceTrivia int       // Player arrivals since the last trivia question
+
+	// fields for the player's step budget
+	movesLeft int // Steps the player may still take this turn
+
+	// fields for co-op
+	humans []*player.Player // Both humans in a co-op match, Player is whichever is taking their turn
+
+	// fields for the route review
+	route []maze.Position // Cells the player arrived on this match, in order
+
+	// fields for wall drawing
+	wallTextureFailed bool // The wall texture couldn't be loaded, don't retry
+
+	// fields for wrong answer penalties
+	previousPos     maze.Position // Cell the player's last step started from
+	actionForfeited bool          // A wrong answer cost this turn's action phase
+
+	// fields for pacing the NPC phase
+	npcDelay int // Frames left before the next NPC acts
+
+	// fields for restarting mid-match
+	confirmingRestart bool // Waiting for the player to confirm a restart
+
+	// fields for the seed entry
+	shownSeed int64 // Seed last written to the seed field, so typing isn't overwritten
+
+	// fields for the trap penalty
+	TurnStartPos maze.Position // Where the player stood when their turn began
 }
 
 // In internal/game/state/state.go
 // Update the New function to ensure proper initialization of the Flavor manager
 
+// New creates a manager using the default settings and the given screen size
 func New(screenWidth, screenHeight int) *Manager {
-    // Increased base size for the maze - will be doubled in maze.New
-    mazeWidth := 10
-    mazeHeight := 10
+    cfg := config.Default()
+    cfg.ScreenWidth, cfg.ScreenHeight = screenWidth, screenHeight
+    return NewWithConfig(cfg)
+}
+
+// NewWithConfig creates a manager using the given settings, including the screen size
+func NewWithConfig(cfg config.Config) *Manager {
+    // Lay everything out for the requested screen size
+    cfg.ScreenWidth, cfg.ScreenHeight = ui.ClampScreenSize(cfg.ScreenWidth, cfg.ScreenHeight)
+    ui.SetScreenSize(cfg.ScreenWidth, cfg.ScreenHeight)
+    
+    // Size of the maze grid in tiles
+    mazeWidth := 20
+    mazeHeight := 20
 
     // Create and initialize the flavor manager first
     flavorMgr := flavor.NewManager()
     
+    // Generate the maze first - it decides where everyone spawns
+    mazeObj := maze.NewWithConfig(maze.Config{
+        Width:    mazeWidth,
+        Height:   mazeHeight,
+        Symmetry: cfg.MazeSymmetry,
+        Seed:     cfg.Seed,
+
+        ExtraPathFactor: cfg.ExtraPathFactor,
+        BorderThickness: cfg.BorderThickness,
+        RoomCount:       cfg.RoomCount,
+        RoomSize:        cfg.RoomSize,
+        StartCorner:     cfg.StartCorner,
+        GoalCorner:      cfg.GoalCorner,
+
+        MinSpawnSeparation: cfg.MinSpawnSeparation,
+    })
+    mazeObj.SetTileSize(cfg.TileSize)
+    tileSize := mazeObj.GetTileSize()
+    start := mazeObj.StartPosition()
+    
     manager := &Manager{
+        Config:           cfg,
         CurrentState:     Menu, // Start with Menu state
         TurnManager:      turn.NewManager(),
-        Player:           player.New(1, 1, maze.TileSize),
-        NPCManager:       npc.NewManager(),
-        Maze:             maze.New(mazeWidth, mazeHeight, 0, 0),
+        Player:           player.New(start.X, start.Y, tileSize),
+        NPCManager:       npc.NewManagerWithSeed(mazeObj.Generator.RandomSeed),
+        Maze:             mazeObj,
         TriviaMgr:        trivia.NewManager(),
         ActionMgr:        action.NewManager(),
         MenuMgr:          menu.NewManager(), // Initialize menu manager
         UIRenderer:       ui.NewRenderer(),
         InputHandler:     ui.NewInputHandler(),
         Flavor:           flavorMgr, // Make sure this is set
+        AnimationMgr:     animation.NewManager(),
+        EventLog:         eventlog.New(eventlog.DefaultCapacity),
+        Events:           events.NewBus(),
+        Logger:           logging.Default(),
+        Clock:            clock.Real,
+        Demo:             NewDemoDriver(),
+        Stats:            NewMatchStats(),
+        Score:            score.New(),
         Winner:           "",
+        TurnStartPos:     start,
         xRotateActive:    false,
         xRotateDirection: 0,
     }
 
-    // Create NPCs
-    npc1 := npc.New(0, 3, 3, maze.TileSize, color.RGBA{255, 0, 0, 255})
-    npc2 := npc.New(1, 5, 5, maze.TileSize, color.RGBA{0, 255, 0, 255})
+    // Apply display settings
+    manager.applyConfig()
+    manager.resetMoves()
+    subscribeEvents(manager.Events, manager.EventLog, manager.UIRenderer)
+
+    // The player has already explored their starting cell
+    manager.floorTiles = mazeObj.State.FloorTileCount()
+    manager.markExplored(start.X, start.Y)
+    manager.recordRoute(start.X, start.Y)
 
-    // Add NPCs to manager
-    manager.NPCManager.AddNPC(npc1)
-    manager.NPCManager.AddNPC(npc2)
+    // Load best results from previous sessions
+    highScores, err := highscore.Load(highscore.DefaultPath)
+    if err != nil {
+        manager.Logger.Warn("failed to load high scores", "err", err)
+    }
+    manager.HighScores = highScores
+
+    // Offer to pick up where the last auto-saved match left off
+    manager.MenuMgr.SetItemHidden("continue_game", !saveExists(DefaultSavePath))
+
+    // Ask the questions the player has missed before more often
+    if path := cfg.TriviaStatsPath; path != "" {
+        if err := manager.TriviaMgr.LoadStats(path); err != nil {
+            manager.Logger.Warn("failed to load trivia stats", "err", err)
+        }
+    }
+
+    // Create NPCs on the spawn cells chosen by the generator
+    npcShapes := []npc.Shape{npc.Square, npc.Circle, npc.Triangle}
+    for i, spawn := range mazeObj.SpawnPositions() {
+        newNPC := npc.New(i, spawn.X, spawn.Y, tileSize, npc.PaletteColor(cfg.NPCPalette, i))
+        newNPC.Pattern = npcPattern(i, cfg.NPCMixedMoves)
+        newNPC.Shape = npcShapes[i%len(npcShapes)]
+        newNPC.MovesPerTurn = cfg.NPCMovesPerTurn
+        newNPC.Easing = cfg.MoveEasing
+        newNPC.Instant = cfg.InstantMovement
+        if cfg.ChaseMode || cfg.WinMode == config.Survival {
+            newNPC.Strategy = npc.Chase
+        }
+        manager.NPCManager.AddNPC(newNPC)
+    }
 
     // Try to load flavor images after initializing the manager
     if flavorMgr != nil {
@@ -89,13 +247,13 @@ func New(screenWidth, screenHeight int) *Manager {
         func() {
             defer func() {
                 if r := recover(); r != nil {
-                    fmt.Println("Warning: Error while loading flavor images:", r)
+                    manager.Logger.Error("panic while loading flavor images", "panic", r)
                 }
             }()
             
             err := flavorMgr.LoadImages("assets")
             if err != nil {
-                fmt.Println("Warning: Failed to load flavor images:", err)
+                manager.Logger.Warn("failed to load flavor images", "err", err)
             }
         }()
     }
@@ -105,6 +263,8 @@ func New(screenWidth, screenHeight int) *Manager {
 
 // Update the Update method to handle menu state
 func (m *Manager) Update() {
+	m.frameDelta = m.tick(m.Clock.Now())
+
 	switch m.CurrentState {
 	case Menu:
 		m.updateMenu()
@@ -113,39 +273,667 @@ func (m *Manager) Update() {
 	case AnsweringTrivia:
 		m.updateTrivia()
 	case GameOver:
-		if m.InputHandler.CheckRestartKey() {
-			// Reset game
-			*m = *New(ui.ScreenWidth, ui.ScreenHeight)
+		// A finished demo or sandbox goes straight back to the menu
+		if m.Demo.Active || m.Sandbox {
+			if !m.AnimationMgr.IsPlaying() || m.InputHandler.AnyKeyPressed() {
+				m.stopDemo()
+			}
+			break
 		}
+
+		m.updateGameOver(m.InputHandler.CheckRestartKey())
+	}
+
+	// Lasting long enough wins a survival match
+	m.checkSurvival()
+
+	// Announce a turn handoff with a banner that doesn't hold up play
+	if m.TurnManager.TakeOwnerChange() && m.CurrentState == Playing {
+		m.AnimationMgr.Play(m.UIRenderer.NewTurnBanner(m.TurnManager.OwnerText()))
 	}
 
+	// Advance any running animations
+	m.AnimationMgr.Update()
+	m.updatePeek()
+
 	// Update action message timer in the UI renderer
-	m.UIRenderer.UpdateActionTimer()
+	m.UIRenderer.UpdateActionTimer(m.frameDelta)
+	m.UIRenderer.Shake.Update()
+	m.UIRenderer.GoalPulse.Update(m.frameDelta)
+	m.Flavor.Update(m.frameDelta)
 
 	// Update action cooldowns
 	m.ActionMgr.UpdateCooldowns()
 }
 
+// updateGameOver resets the game when restart is pressed, ignoring the
+// input until the celebration has finished playing
+func (m *Manager) updateGameOver(restartPressed bool) {
+	if restartPressed && !m.AnimationMgr.IsPlaying() {
+		m.reset()
+	}
+}
+
+// tick returns the seconds since the previous update, assuming a 60 FPS
+// frame on the first call and capping long stalls at MaxFrameDelta
+func (m *Manager) tick(now time.Time) float64 {
+	dt := 1.0 / 60
+	if !m.lastUpdate.IsZero() {
+		dt = now.Sub(m.lastUpdate).Seconds()
+	}
+	m.lastUpdate = now
+
+	if dt < 0 {
+		dt = 0
+	} else if dt > MaxFrameDelta {
+		dt = MaxFrameDelta
+	}
+	return dt
+}
+
+// Log records a game event tagged with the current turn number
+func (m *Manager) Log(msg string) {
+	m.EventLog.Add(m.TurnManager.TurnNumber, msg)
+}
+
+// startMatch leaves the menu and begins play on the current maze
+func (m *Manager) startMatch() {
+	m.CurrentState = Playing
+	m.matchStart = m.Clock.Now()
+	m.TriviaMgr.ResetSession()
+	m.setupCoop()
+	m.announceDifficulty()
+}
+
+// restartMatch throws the current match away and starts another with the
+// same settings. Unless a seed is set the maze is a new one
+func (m *Manager) restartMatch() {
+	if m.Practice {
+		m.startPractice()
+		return
+	}
+	m.reset()
+	m.startMatch()
+}
+
+// announceDifficulty tells the player how hard the generated maze is
+func (m *Manager) announceDifficulty() {
+	msg := fmt.Sprintf("Maze difficulty: %.1f / %.0f", m.Maze.Difficulty(), maze.MaxDifficulty)
+	m.UIRenderer.SetActionMessage(msg, 180)
+	m.Log(msg)
+}
+
 // Add the updateMenu method
 func (m *Manager) updateMenu() {
-	action := m.MenuMgr.HandleInput()
+	// Start the demo once the menu has been left idle for a while
+	if m.Demo.TrackIdle(m.InputHandler.AnyKeyPressed()) {
+		m.startDemo()
+		return
+	}
+
+	m.handleMenuAction(m.MenuMgr.HandleInput())
+}
 
+// handleMenuAction carries out the action chosen from the menu
+func (m *Manager) handleMenuAction(action string) {
 	if action == "start_game" {
 		// Start the game
-		m.CurrentState = Playing
+		m.startMatch()
+	} else if action == "continue_game" {
+		m.continueGame()
+	} else if action == "start_sandbox" {
+		m.startSandbox()
+	} else if action == "start_practice" {
+		m.startPractice()
+	} else if action == "toggle_shake" {
+		// Accessibility: allow turning off screen shake
+		m.Config.ScreenShake = !m.Config.ScreenShake
+		m.applyConfig()
+	} else if action == "toggle_goal_pulse" {
+		// Accessibility: reduced motion keeps the goal still
+		m.Config.GoalPulse = !m.Config.GoalPulse
+		m.applyConfig()
+	} else if action == "toggle_contrast" {
+		// Accessibility: easier to read text
+		m.Config.HighContrast = !m.Config.HighContrast
+		m.applyConfig()
+	} else if action == "toggle_debug" {
+		// Development and accessibility keys such as revealing the maze
+		m.Config.DebugKeys = !m.Config.DebugKeys
+		m.applyConfig()
+	} else if action == "toggle_explore_hint" {
+		// Nudge the player toward parts of the maze they haven't seen
+		m.Config.ExploreHint = !m.Config.ExploreHint
+		m.applyConfig()
+	} else if action == "toggle_fit_maze" {
+		// Shrink big mazes to their section instead of cutting them off
+		m.Config.FitMaze = !m.Config.FitMaze
+		m.applyConfig()
+	} else if action == "toggle_lighting" {
+		// A softer alternative to fog, off for players who need to see it all
+		m.Config.Lighting = !m.Config.Lighting
+		m.applyConfig()
+	} else if action == "cycle_light_radius" {
+		m.Config.LightRadius = nextLightRadius(m.Config.LightRadius)
+		m.applyConfig()
+	} else if action == "cycle_wall_style" {
+		m.Config.WallStyle = m.Config.WallStyle.Next()
+		m.applyConfig()
+	} else if action == "cycle_easing" {
+		m.Config.MoveEasing = m.Config.MoveEasing.Next()
+		m.applyConfig()
+	} else if action == "toggle_autosave" {
+		m.Config.AutoSave = !m.Config.AutoSave
+		m.applyConfig()
+	} else if action == "toggle_instant_moves" {
+		// Grid-locked movement for players who find the slide distracting
+		m.Config.InstantMovement = !m.Config.InstantMovement
+		m.applyConfig()
+	} else if action == "cycle_symmetry" {
+		// Regenerate with the next symmetry option so the next match uses it
+		m.Config.MazeSymmetry = m.Config.MazeSymmetry.Next()
+		m.resetToCustomize()
+	} else if action == "cycle_action_mode" {
+		m.Config.ActionMode = m.Config.ActionMode.Next()
+		m.applyConfig()
+	} else if action == "cycle_popup_anchor" {
+		m.Config.ActionPopupAnchor = m.Config.ActionPopupAnchor.Next()
+		m.applyConfig()
+	} else if action == "cycle_trivia" {
+		m.Config.TriviaFrequency = nextTriviaFrequency(m.Config.TriviaFrequency)
+		m.applyConfig()
+	} else if action == "toggle_trivia_shuffle" {
+		// Stop players memorising which number the answer is
+		m.Config.TriviaShuffle = !m.Config.TriviaShuffle
+		m.applyConfig()
+	} else if action == "cycle_wrong_answer" {
+		// Make trivia matter to how far the player gets
+		m.Config.WrongAnswerPenalty = m.Config.WrongAnswerPenalty.Next()
+		m.applyConfig()
+	} else if action == "toggle_chase" {
+		// NPCs are built with the match, so rebuild it with chasers
+		m.Config.ChaseMode = !m.Config.ChaseMode
+		m.resetToCustomize()
+	} else if action == "toggle_coop" {
+		// A second human joins when the match starts
+		m.Config.CoopMode = !m.Config.CoopMode
+		m.applyConfig()
+	} else if action == "cycle_win_mode" {
+		// Survival needs chasers, so rebuild the NPCs for it
+		m.Config.WinMode = m.Config.WinMode.Next()
+		m.resetToCustomize()
+	} else if action == "cycle_survival_turns" {
+		m.Config.SurvivalTurns = nextSurvivalTurns(m.Config.SurvivalTurns)
+		m.applyConfig()
+	} else if action == "cycle_target_score" {
+		m.Config.TargetScore = nextTargetScore(m.Config.TargetScore)
+		m.applyConfig()
+	} else if action == "cycle_player_moves" {
+		// Let the player cover more ground before the action phase
+		m.Config.PlayerMovesPerTurn = m.Config.PlayerMovesPerTurn%player.MaxMovesPerTurn + 1
+		m.applyConfig()
+		m.resetMoves()
+	} else if action == "cycle_npc_moves" {
+		// Harder difficulties let NPCs take several steps per turn
+		m.Config.NPCMovesPerTurn = m.Config.NPCMovesPerTurn%npc.MaxMovesPerTurn + 1
+		m.applyConfig()
+	} else if action == "cycle_npc_delay" {
+		// Slow the NPC phase down so every move is easy to follow
+		m.Config.NPCDelayFrames = nextNPCDelay(m.Config.NPCDelayFrames)
+		m.applyConfig()
+	} else if action == "cycle_npc_wander" {
+		// Let NPCs slip up now and then so they feel less robotic
+		m.Config.NPCWanderChance = nextWanderChance(m.Config.NPCWanderChance)
+		m.applyConfig()
+	} else if action == "cycle_npc_rotate" {
+		// NPCs that rotate rows against the player make a harder match
+		m.Config.NPCRotateChance = nextRotateChance(m.Config.NPCRotateChance)
+		m.applyConfig()
+	} else if action == "toggle_npc_patterns" {
+		// Knights and diagonal movers make each NPC feel distinct
+		m.Config.NPCMixedMoves = !m.Config.NPCMixedMoves
+		m.applyConfig()
+	} else if action == "cycle_loops" {
+		// Tune between a labyrinth and an open field
+		m.Config.ExtraPathFactor = nextLoopFactor(m.Config.ExtraPathFactor)
+		m.resetToCustomize()
+	} else if action == "cycle_rooms" {
+		// Open areas break up the corridors
+		m.Config.RoomCount = nextRoomCount(m.Config.RoomCount)
+		m.resetToCustomize()
+	} else if action == "cycle_room_size" {
+		m.Config.RoomSize = nextRoomSize(m.Config.RoomSize)
+		m.resetToCustomize()
+	} else if action == "cycle_start_corner" {
+		m.Config.StartCorner = m.Config.StartCorner.NextStart()
+		m.resetToCustomize()
+	} else if action == "cycle_goal_corner" {
+		m.Config.GoalCorner = m.Config.GoalCorner.Next()
+		m.resetToCustomize()
+	} else if action == "cycle_shrink" {
+		// Close the maze in every few rounds to force a finish
+		m.Config.ShrinkEvery = nextShrinkInterval(m.Config.ShrinkEvery)
+		m.applyConfig()
+	} else if action == "set_seed" {
+		// Regenerate with the typed seed, an empty field goes back to random
+		seed, err := parseSeed(m.MenuMgr.ItemValue("set_seed"))
+		if err != nil {
+			m.Logger.Warn("invalid seed", "err", err)
+			m.UIRenderer.ShowMessage("Seed must be a whole number", 2, ui.ErrorMessage)
+			return
+		}
+		m.Config.Seed = seed
+		m.resetToCustomize()
+	} else if action == "reroll_maze" {
+		// A fresh random maze, or the same one again while a seed is set
+		m.resetToCustomize()
 	} else if action == "quit" {
-		// Quit the game
-		// In a real implementation, you'd handle this differently
-		// For now, we'll just switch to game over state
-		m.Winner = "Quit"
-		m.CurrentState = GameOver
+		// Quit confirmed - signal the game loop to stop and let the caller know
+		m.ShouldExit = true
+		if m.OnQuit != nil {
+			m.OnQuit()
+		}
+	}
+}
+
+// reset rebuilds the manager for a fresh match, keeping the current settings,
+// logger, clock and quit hook
+func (m *Manager) reset() {
+	logger, clk, onQuit := m.Logger, m.Clock, m.OnQuit
+	triviaStats := m.TriviaMgr.Stats()
+	*m = *NewWithConfig(m.Config)
+	m.TriviaMgr.SetStats(triviaStats)
+	m.OnQuit = onQuit
+	if logger != nil {
+		m.Logger = logger
+		m.Flavor.Logger = logger
+	}
+	if clk != nil {
+		m.SetClock(clk)
+	}
+}
+
+// SetClock makes the manager and its animations keep time with c
+func (m *Manager) SetClock(c clock.Clock) {
+	m.Clock = c
+	m.AnimationMgr.Clock = c
+}
+
+// applyConfig pushes the current settings to the subsystems that use them
+func (m *Manager) applyConfig() {
+	m.UIRenderer.Shake.Enabled = m.Config.ScreenShake
+	m.UIRenderer.GoalPulse.Enabled = m.Config.GoalPulse
+	m.TriviaMgr.ShuffleOptions = m.Config.TriviaShuffle
+	m.UIRenderer.HighContrast = m.Config.HighContrast
+	m.ActionMgr.SetMode(m.Config.ActionMode)
+	m.ActionMgr.PageSize = m.Config.ActionsPerPage
+	m.UIRenderer.PopupAnchor = m.Config.ActionPopupAnchor
+	m.UIRenderer.FitMaze = m.Config.FitMaze
+	m.UIRenderer.TargetScore = 0
+	if m.scoreRace() {
+		m.UIRenderer.TargetScore = m.Config.TargetScore
+	}
+	m.UIRenderer.MazeOptions.LightRadius = 0
+	if m.Config.Lighting {
+		m.UIRenderer.MazeOptions.LightRadius = m.Config.LightRadius
+	}
+	m.UIRenderer.MazeOptions.WallStyle = m.Config.WallStyle
+	m.loadWallTexture()
+	m.Player.Easing = m.Config.MoveEasing
+	m.Player.Instant = m.Config.InstantMovement
+	if !m.Sandbox {
+		m.NPCManager.RotateChance = m.Config.NPCRotateChance
+	}
+	for i, n := range m.NPCManager.NPCs {
+		n.Easing = m.Config.MoveEasing
+		n.Instant = m.Config.InstantMovement
+		n.Mix = npc.Mixed(n.Strategy, m.Config.NPCWanderChance)
+		if !m.Sandbox {
+			n.Pattern = npcPattern(i, m.Config.NPCMixedMoves)
+			n.MovesPerTurn = m.Config.NPCMovesPerTurn
+		}
+	}
+	if !m.Config.DebugKeys {
+		m.UIRenderer.MazeOptions.Reveal = false
+		m.UIRenderer.MazeOptions.Heatmap = false
+		m.UIRenderer.MazeOptions.Coordinates = false
+	}
+
+	m.MenuMgr.SetItemText("toggle_shake", "Screen Shake: "+onOff(m.Config.ScreenShake))
+	m.MenuMgr.SetItemText("toggle_goal_pulse", "Goal Pulse: "+onOff(m.Config.GoalPulse))
+	m.MenuMgr.SetItemText("toggle_contrast", "High Contrast: "+onOff(m.Config.HighContrast))
+	m.MenuMgr.SetItemText("toggle_debug", "Debug Keys: "+onOff(m.Config.DebugKeys))
+	m.MenuMgr.SetItemText("toggle_explore_hint", "Explore Hint: "+onOff(m.Config.ExploreHint))
+	m.MenuMgr.SetItemText("toggle_fit_maze", "Fit Maze: "+onOff(m.Config.FitMaze))
+	m.MenuMgr.SetItemText("toggle_lighting", "Lighting: "+onOff(m.Config.Lighting))
+	m.MenuMgr.SetItemText("cycle_light_radius", fmt.Sprintf("Light Radius: %g", m.Config.LightRadius))
+	m.MenuMgr.SetItemText("cycle_wall_style", "Walls: "+m.Config.WallStyle.String())
+	m.MenuMgr.SetItemText("cycle_easing", "Movement: "+m.Config.MoveEasing.String())
+	m.MenuMgr.SetItemText("toggle_instant_moves", "Instant Moves: "+onOff(m.Config.InstantMovement))
+	m.MenuMgr.SetItemText("toggle_autosave", "Auto-Save: "+onOff(m.Config.AutoSave))
+	m.MenuMgr.SetItemText("cycle_symmetry", "Symmetry: "+m.Config.MazeSymmetry.String())
+	m.MenuMgr.SetItemText("cycle_action_mode", "Actions: "+m.Config.ActionMode.String())
+	m.MenuMgr.SetItemText("cycle_popup_anchor", "Action Popup: "+m.Config.ActionPopupAnchor.String())
+	m.MenuMgr.SetItemText("cycle_trivia", "Trivia: "+formatTriviaFrequency(m.Config.TriviaFrequency))
+	m.MenuMgr.SetItemText("toggle_trivia_shuffle", "Shuffle Answers: "+onOff(m.Config.TriviaShuffle))
+	m.MenuMgr.SetItemText("cycle_wrong_answer", "Wrong Answer: "+m.Config.WrongAnswerPenalty.String())
+	m.MenuMgr.SetItemText("cycle_player_moves", fmt.Sprintf("Player Moves: %d", m.Config.PlayerMovesPerTurn))
+	m.MenuMgr.SetItemText("cycle_npc_moves", fmt.Sprintf("NPC Moves: %d", m.Config.NPCMovesPerTurn))
+	m.MenuMgr.SetItemText("cycle_npc_delay", "NPC Delay: "+formatNPCDelay(m.Config.NPCDelayFrames))
+	m.MenuMgr.SetItemText("cycle_npc_wander", fmt.Sprintf("NPC Randomness: %.0f%%", m.Config.NPCWanderChance*100))
+	// Only chasers have a plan to stray from, wandering NPCs ignore it
+	m.MenuMgr.SetItemHidden("cycle_npc_wander", !m.chasing())
+	m.MenuMgr.SetItemText("cycle_npc_rotate", "NPC Rotations: "+formatRotateChance(m.Config.NPCRotateChance))
+	m.MenuMgr.SetItemText("toggle_npc_patterns", "NPC Patterns: "+formatNPCPatterns(m.Config.NPCMixedMoves))
+	m.MenuMgr.SetItemText("toggle_chase", "NPC Chase: "+onOff(m.Config.ChaseMode))
+	m.MenuMgr.SetItemText("toggle_coop", "Co-op: "+onOff(m.Config.CoopMode))
+	m.MenuMgr.SetItemText("cycle_win_mode", "Win: "+m.Config.WinMode.String())
+	m.MenuMgr.SetItemText("cycle_survival_turns", fmt.Sprintf("Survive: %d Turns", m.Config.SurvivalTurns))
+	m.MenuMgr.SetItemText("cycle_target_score", fmt.Sprintf("Target: %d Points", m.Config.TargetScore))
+	m.MenuMgr.SetItemText("cycle_loops", fmt.Sprintf("Loops: %.1f", m.Config.ExtraPathFactor))
+	m.MenuMgr.SetItemText("cycle_rooms", fmt.Sprintf("Rooms: %d", m.Config.RoomCount))
+	m.MenuMgr.SetItemText("cycle_room_size", fmt.Sprintf("Room Size: %d", m.Config.RoomSize))
+	m.MenuMgr.SetItemText("cycle_start_corner", "Start: "+m.Config.StartCorner.String())
+	m.MenuMgr.SetItemText("cycle_goal_corner", "Goal: "+m.Config.GoalCorner.String())
+	m.MenuMgr.SetItemText("cycle_shrink", "Shrinking Maze: "+formatShrinkInterval(m.Config.ShrinkEvery))
+	if m.Config.Seed != m.shownSeed {
+		m.MenuMgr.SetItemValue("set_seed", formatSeed(m.Config.Seed))
+		m.shownSeed = m.Config.Seed
+	}
+}
+
+// triviaFrequencies are the trivia settings offered in the customize menu,
+// as the number of moves between questions with 0 for never
+var triviaFrequencies = []int{0, 1, 2, 3, 5}
+
+// nextTriviaFrequency returns the trivia setting after current in the menu cycle
+func nextTriviaFrequency(current int) int {
+	for _, frequency := range triviaFrequencies {
+		if frequency > current {
+			return frequency
+		}
+	}
+	return triviaFrequencies[0]
+}
+
+// formatTriviaFrequency formats a trivia setting for display in the menu
+func formatTriviaFrequency(frequency int) string {
+	switch {
+	case frequency <= 0:
+		return "Off"
+	case frequency == 1:
+		return "Every Move"
+	default:
+		return fmt.Sprintf("Every %d Moves", frequency)
+	}
+}
+
+// lightRadii are the light radii offered in the customize menu, in tiles
+var lightRadii = []float64{3, 5, 8}
+
+// nextLightRadius returns the light radius after current in the menu cycle
+func nextLightRadius(current float64) float64 {
+	for _, radius := range lightRadii {
+		if radius > current {
+			return radius
+		}
 	}
+	return lightRadii[0]
+}
+
+// npcDelays are the pauses between NPC moves offered in the customize menu, in frames
+var npcDelays = []int{0, 15, 30, 60}
+
+// nextNPCDelay returns the NPC delay after current in the menu cycle
+func nextNPCDelay(current int) int {
+	for _, delay := range npcDelays {
+		if delay > current {
+			return delay
+		}
+	}
+	return npcDelays[0]
+}
+
+// formatNPCDelay formats an NPC delay for display in the menu
+func formatNPCDelay(frames int) string {
+	if frames <= 0 {
+		return "Off"
+	}
+	return fmt.Sprintf("%.2fs", float64(frames)/60)
+}
+
+// wanderChances are the NPC randomness settings offered in the customize menu
+var wanderChances = []float64{0, 0.1, 0.3, 0.5}
+
+// nextWanderChance returns the NPC randomness after current in the menu cycle
+func nextWanderChance(current float64) float64 {
+	for _, chance := range wanderChances {
+		if chance > current {
+			return chance
+		}
+	}
+	return wanderChances[0]
+}
+
+// rotateChances are the NPC rotation settings offered in the customize menu
+var rotateChances = []float64{0, 0.1, 0.25, 0.5}
+
+// nextRotateChance returns the NPC rotation chance after current in the menu cycle
+func nextRotateChance(current float64) float64 {
+	for _, chance := range rotateChances {
+		if chance > current {
+			return chance
+		}
+	}
+	return rotateChances[0]
+}
+
+// formatRotateChance formats an NPC rotation chance for display in the menu
+func formatRotateChance(chance float64) string {
+	if chance <= 0 {
+		return "Off"
+	}
+	return fmt.Sprintf("%.0f%%", chance*100)
+}
+
+// mixedPatterns are handed out by NPC index when mixed moves are chosen
+var mixedPatterns = []npc.MovementPattern{npc.Orthogonal, npc.Knight, npc.Diagonal}
+
+// npcPattern returns the movement pattern of the i-th NPC. Every NPC
+// steps orthogonally unless mixed moves are chosen in the settings
+func npcPattern(i int, mixed bool) npc.MovementPattern {
+	if !mixed {
+		return npc.Orthogonal
+	}
+	return mixedPatterns[i%len(mixedPatterns)]
+}
+
+// formatNPCPatterns labels the NPC movement setting for the menu
+func formatNPCPatterns(mixed bool) string {
+	if mixed {
+		return "Mixed"
+	}
+	return "Orthogonal"
+}
+
+// loopFactors are the loop densities offered in the customize menu
+var loopFactors = []float64{0, 0.5, 1, 2, maze.MaxExtraPathFactor}
+
+// nextLoopFactor returns the loop density after current in the menu cycle
+func nextLoopFactor(current float64) float64 {
+	for _, factor := range loopFactors {
+		if factor > current {
+			return factor
+		}
+	}
+	return loopFactors[0]
+}
+
+// roomCounts are the numbers of rooms offered in the customize menu
+var roomCounts = []int{0, 2, 4, 6}
+
+// nextRoomCount returns the room count after current in the menu cycle
+func nextRoomCount(current int) int {
+	for _, count := range roomCounts {
+		if count > current {
+			return count
+		}
+	}
+	return roomCounts[0]
+}
+
+// roomSizes are the largest room sides offered in the customize menu
+var roomSizes = []int{3, maze.DefaultRoomMaxSize, 7}
+
+// nextRoomSize returns the room size after current in the menu cycle
+func nextRoomSize(current int) int {
+	for _, size := range roomSizes {
+		if size > current {
+			return size
+		}
+	}
+	return roomSizes[0]
+}
+
+// parseSeed converts the typed seed to a number, empty meaning random (0)
+func parseSeed(text string) (int64, error) {
+	if text == "" {
+		return 0, nil
+	}
+	return strconv.ParseInt(text, 10, 64)
+}
+
+// formatSeed shows a seed in the menu, leaving random seeds blank
+func formatSeed(seed int64) string {
+	if seed == 0 {
+		return ""
+	}
+	return strconv.FormatInt(seed, 10)
+}
+
+// resetToCustomize rebuilds the match after a setting that affects maze
+// generation changed, keeping the customize menu open at the same item
+func (m *Manager) resetToCustomize() {
+	selected := m.MenuMgr.CurrentMenu.Selected
+	m.reset()
+	m.MenuMgr.OpenSubmenu("Customize", selected)
+}
+
+// loadWallTexture loads the wall texture the first time textured walls are
+// picked. Without it walls are drawn solid
+func (m *Manager) loadWallTexture() {
+	if m.Config.WallStyle != ui.TexturedWalls || m.UIRenderer.MazeOptions.WallTexture != nil || m.wallTextureFailed {
+		return
+	}
+	texture, err := flavor.LoadTexture(flavor.DefaultWallTexture)
+	if err != nil {
+		m.wallTextureFailed = true
+		m.Logger.Warn("failed to load wall texture, drawing solid walls", "err", err)
+		return
+	}
+	m.UIRenderer.MazeOptions.WallTexture = texture
+}
+
+// onOff formats a setting for display in the menu
+func onOff(enabled bool) string {
+	if enabled {
+		return "On"
+	}
+	return "Off"
+}
+
+// startDemo begins a fresh match with the demo driver controlling the player
+func (m *Manager) startDemo() {
+	m.reset()
+	m.Demo.Start(m.Maze, m.Player)
+	m.CurrentState = Playing
+	m.UIRenderer.SetActionMessage("Demo - press any key", 0)
+}
+
+// stopDemo abandons the demo match and returns to a fresh menu
+func (m *Manager) stopDemo() {
+	m.reset()
+}
+
+// moveSource returns whatever is currently driving the player
+func (m *Manager) moveSource() MoveSource {
+	if m.Demo.Active {
+		return m.Demo
+	}
+	return m.InputHandler
+}
+
+// endPlayerTurn switches to the NPCs and resets their movement tracking
+func (m *Manager) endPlayerTurn() {
+	// Rotations can only be undone within the turn they were made
+	m.lastRotation = nil
+	m.TurnManager.EndTurn()
+	m.publish(events.Event{Type: events.TurnChanged, PlayerTurn: m.TurnManager.IsPlayerTurn()})
+	// Reset NPC movement tracking for the new turn if switching to NPC turn
+	if m.TurnManager.CurrentOwner == turn.NPCTurn {
+		m.NPCManager.ResetMovedStatus()
+	} else {
+		// Over to the next co-op human
+		m.beginPlayerTurn()
+	}
+	m.autoSave()
 }
 
 // Update while playing
 func (m *Manager) updatePlaying() {
+	// Any key press ends the demo and returns to the menu
+	if m.Demo.Active && m.InputHandler.AnyKeyPressed() {
+		m.stopDemo()
+		return
+	}
+
+	// Escape leaves the sandbox
+	if m.Sandbox && m.InputHandler.CheckCancelKey() {
+		m.reset()
+		return
+	}
+
+	// A restart has to be confirmed, and play waits while it is asked
+	if m.confirmingRestart {
+		if m.InputHandler.CheckYesKey() {
+			m.restartMatch()
+		} else if m.InputHandler.AnyKeyPressed() {
+			m.confirmingRestart = false
+			m.UIRenderer.SetActionMessage("Restart cancelled", 60)
+		}
+		return
+	}
+	if !m.Sandbox && !m.Demo.Active && m.InputHandler.CheckRestartMatchKey() {
+		m.confirmingRestart = true
+		m.UIRenderer.SetActionMessage("Restart with a new maze? Y: Yes, any other key: No", 0)
+		return
+	}
+
+	// Toggle the visit heatmap debug view
+	if m.Config.DebugKeys && m.InputHandler.CheckHeatmapKey() {
+		m.UIRenderer.ToggleHeatmap()
+	}
+
+	// Toggle the tile coordinates debug overlay
+	if m.Config.DebugKeys && m.InputHandler.CheckCoordinatesKey() {
+		m.UIRenderer.ToggleCoordinates()
+	}
+
+	// Flip through the loaded flavor images by hand
+	if m.InputHandler.CheckNextFlavorKey() {
+		m.Flavor.Next()
+	} else if m.InputHandler.CheckPrevFlavorKey() {
+		m.Flavor.Prev()
+	}
+
+	// Toggle the reveal cheat view, only while debug keys are enabled
+	if m.Config.DebugKeys && m.InputHandler.CheckRevealKey() {
+		m.UIRenderer.ToggleReveal()
+	}
+
 	// Update positions for smooth movement
 	m.updatePositions()
+	m.updateExploreHint()
 
 	// If X-rotate is active, handle confirmation or cancellation
 	if m.xRotateActive {
@@ -153,10 +941,21 @@ func (m *Manager) updatePlaying() {
 		return
 	}
 
+	// If row shuffle is active, handle confirmation or cancellation
+	if m.shuffleActive {
+		m.handleShuffleConfirmation()
+		return
+	}
+
 	// Process based on turn state
 	switch m.TurnManager.CurrentState {
 	case turn.WaitingForMove:
 		if m.TurnManager.IsPlayerTurn() {
+			// After at least one step the player may stop early
+			if m.movesLeft < m.movesPerTurn() && !m.Player.IsMoving() && m.InputHandler.CheckDoneMovingKey() {
+				m.stopMoving()
+				break
+			}
 			m.handlePlayerMovement()
 		} else {
 			m.processNPCTurn()
@@ -164,16 +963,16 @@ func (m *Manager) updatePlaying() {
 
 	case turn.WaitingForAction:
 		// Player can now either show the action menu or end their turn directly
-		if m.InputHandler.CheckActionKey() {
-			// Show action menu
+		if m.Demo.Active {
+			// The demo never uses actions
+			m.endPlayerTurn()
+		} else if m.InputHandler.CheckActionKey() {
+			// Show action menu, starting from its first page
+			m.ActionMgr.ResetPage()
 			m.TurnManager.NextState(turn.SelectingAction)
 		} else if m.InputHandler.CheckEndTurnKey() {
 			// Skip action and end turn
-			m.TurnManager.EndTurn()
-			// Reset NPC movement tracking for the new turn if switching to NPC turn
-			if m.TurnManager.CurrentOwner == turn.NPCTurn {
-				m.NPCManager.ResetMovedStatus()
-			}
+			m.endPlayerTurn()
 		}
 
 	case turn.SelectingAction:
@@ -182,6 +981,10 @@ func (m *Manager) updatePlaying() {
 			// Return to the WaitingForAction state
 			m.TurnManager.NextState(turn.WaitingForAction)
 			m.UIRenderer.SetActionMessage("Action selection cancelled", 60)
+		} else if m.InputHandler.CheckNextPageKey() {
+			m.ActionMgr.NextPage()
+		} else if m.InputHandler.CheckPrevPageKey() {
+			m.ActionMgr.PrevPage()
 		} else {
 			// Check for action number input
 			actionNum := m.InputHandler.CheckActionSelectionInput()
@@ -196,13 +999,11 @@ func (m *Manager) updatePlaying() {
 		}
 
 	case turn.WaitingForEndTurn:
-		if m.InputHandler.CheckEndTurnKey() {
+		if m.lastRotation != nil && m.InputHandler.CheckUndoKey() {
+			m.undoRotation()
+		} else if m.Demo.Active || m.InputHandler.CheckEndTurnKey() {
 			// End turn and switch to next actor
-			m.TurnManager.EndTurn()
-			// Reset NPC movement tracking for the new turn if switching to NPC turn
-			if m.TurnManager.CurrentOwner == turn.NPCTurn {
-				m.NPCManager.ResetMovedStatus()
-			}
+			m.endPlayerTurn()
 		}
 
 	case turn.ProcessingNPCTurn:
@@ -213,13 +1014,16 @@ func (m *Manager) updatePlaying() {
 	}
 }
 
-// Add this method to the Manager struct to collect entity positions
+// collectEntityPositions returns every human's position, in turn order,
+// followed by every NPC's
 func (m *Manager) collectEntityPositions() []maze.Position {
     positions := []maze.Position{}
     
-    // Add player position
-    playerGridX, playerGridY := m.Player.GetGridPosition()
-    positions = append(positions, maze.Position{X: playerGridX, Y: playerGridY})
+    // Add every human's position, the waiting teammate too in co-op
+    for _, human := range m.humanPlayers() {
+        humanGridX, humanGridY := human.GetGridPosition()
+        positions = append(positions, maze.Position{X: humanGridX, Y: humanGridY})
+    }
     
     // Add NPC positions
     for _, npc := range m.NPCManager.NPCs {
@@ -229,59 +1033,200 @@ func (m *Manager) collectEntityPositions() []maze.Position {
     return positions
 }
 
+// allReachGoal checks that the goal can be reached from every position
+func (m *Manager) allReachGoal(positions []maze.Position) bool {
+	for _, pos := range positions {
+		if !m.Maze.CanReachGoal(pos) {
+			return false
+		}
+	}
+	return true
+}
+
 // Modify the handleXRotateConfirmation method to check for collisions
 func (m *Manager) handleXRotateConfirmation() {
-    // Check for confirmation
-    if m.InputHandler.CheckConfirmKey() {
-        playerGridX, playerGridY := m.Player.GetGridPosition()
-        
-        // Collect all entity positions
-        entityPositions := m.collectEntityPositions()
-        
-        // Check for collisions
-        hasCollision := m.Maze.CheckXRotateCollisions(
-            playerGridX,
-            playerGridY,
-            m.xRotateDirection,
-            entityPositions,
-        )
-        
-        if hasCollision {
-            // Cancel the action due to collision
-            m.Maze.ClearHighlights()
-            m.xRotateActive = false
-            m.UIRenderer.SetActionMessage("Cannot move wall segments on top of players or NPCs", 120)
-            m.TurnManager.NextState(turn.WaitingForAction)
-            return
-        }
+	if m.InputHandler.CheckConfirmKey() {
+		m.confirmXRotate()
+	}
+	if m.InputHandler.CheckCancelKey() {
+		m.cancelXRotate()
+	}
+}
+
+// confirmXRotate performs the pending X-rotation. The action is only
+// spent once the rotation has actually been applied
+func (m *Manager) confirmXRotate() {
+	playerGridX, playerGridY := m.Player.GetGridPosition()
 
-		// No collision, perform the rotation
-		m.Maze.PerformXRotate(playerGridX, playerGridY, m.xRotateDirection)
+	// Collect all entity positions
+	entityPositions := m.collectEntityPositions()
 
-		// Mark the action as used
-		if m.xRotateDirection > 0 {
-			m.ActionMgr.UseAction(action.XRotateRight)
-			m.UIRenderer.SetActionMessage("X-Rotate Right Used!", 60)
-		} else {
-			m.ActionMgr.UseAction(action.XRotateLeft)
-			m.UIRenderer.SetActionMessage("X-Rotate Left Used!", 60)
-		}
+	// Check for collisions
+	hasCollision := m.Maze.CheckXRotateCollisions(
+		playerGridX,
+		playerGridY,
+		m.xRotateDirection,
+		entityPositions,
+	)
+
+	if hasCollision {
+		// Cancel the action due to collision. UseAction has not been
+		// called yet, so the player keeps the action
+		m.Maze.ClearHighlights()
+		m.xRotateActive = false
+		m.UIRenderer.MazeOptions.Preview = nil
+		m.UIRenderer.ShowMessage("Cannot move wall segments on top of players or NPCs", 2, ui.ErrorMessage)
+		m.Log("X-Rotate blocked")
+		m.TurnManager.NextState(turn.WaitingForAction)
+		return
+	}
 
-		// Clear state and move to end turn
+	// No collision, perform the rotation, keeping the row for undo
+	saved := m.Maze.State.SnapshotRow(playerGridY)
+	m.Maze.PerformXRotate(playerGridX, playerGridY, m.xRotateDirection)
+
+	// Undo rotations that cut the player or any NPC off from the goal
+	if !m.allReachGoal(entityPositions) {
+		m.Maze.PerformXRotate(playerGridX, playerGridY, -m.xRotateDirection)
 		m.xRotateActive = false
+		m.UIRenderer.MazeOptions.Preview = nil
+		m.UIRenderer.ShowMessage("That move would trap everyone", 2, ui.ErrorMessage)
+		m.Log("X-Rotate reverted")
+		m.TurnManager.NextState(turn.WaitingForAction)
+		return
+	}
+
+	// Mark the action as used
+	used := action.XRotateLeft
+	if m.xRotateDirection > 0 {
+		used = action.XRotateRight
+	}
+	m.useAction(used)
+	m.lastRotation = &rotationUndo{Y: playerGridY, Tiles: saved, Action: used}
+	m.UIRenderer.SetActionMessage("X-Rotate done (Undo: U, End Turn: Space)", 120)
+
+	// Clear state and move to end turn
+	m.xRotateActive = false
+	m.UIRenderer.MazeOptions.Preview = nil
+	m.TurnManager.NextState(turn.WaitingForEndTurn)
+}
+
+// cancelXRotate leaves X-rotate mode without spending the action
+func (m *Manager) cancelXRotate() {
+	// Clear highlights and exit X-rotate mode
+	m.Maze.ClearHighlights()
+	m.xRotateActive = false
+	m.UIRenderer.MazeOptions.Preview = nil
+	m.UIRenderer.SetActionMessage("X-Rotate Cancelled", 60)
+	m.TurnManager.NextState(turn.WaitingForAction)
+}
+
+// showRotationPreview shows where the pending X-rotation will move tiles
+func (m *Manager) showRotationPreview() {
+	playerGridX, playerGridY := m.Player.GetGridPosition()
+	m.UIRenderer.MazeOptions.Preview = &ui.RowPreview{
+		Y:     playerGridY,
+		Tiles: m.Maze.SimulateXRotate(playerGridX, playerGridY, m.xRotateDirection),
+	}
+}
+
+// handleShuffleConfirmation applies or cancels a pending row shuffle
+func (m *Manager) handleShuffleConfirmation() {
+	if m.InputHandler.CheckConfirmKey() {
+		playerGridX, playerGridY := m.Player.GetGridPosition()
+
+		m.shuffleActive = false
+		if !m.Maze.ShuffleRow(playerGridX, playerGridY, m.collectEntityPositions()) {
+			// Rejected shuffles don't cost the action
+			m.Maze.ClearHighlights()
+			m.UIRenderer.ShowMessage("Shuffle would trap someone - try again later", 2, ui.ErrorMessage)
+			m.Log("Shuffle Row blocked")
+			m.TurnManager.NextState(turn.WaitingForAction)
+			return
+		}
+
+		m.useAction(action.ShuffleRow)
 		m.TurnManager.NextState(turn.WaitingForEndTurn)
+		return
 	}
 
-	// Check for cancellation
 	if m.InputHandler.CheckCancelKey() {
-		// Clear highlights and exit X-rotate mode
 		m.Maze.ClearHighlights()
-		m.xRotateActive = false
-		m.UIRenderer.SetActionMessage("X-Rotate Cancelled", 60)
+		m.shuffleActive = false
+		m.UIRenderer.SetActionMessage("Shuffle Cancelled", 60)
 		m.TurnManager.NextState(turn.WaitingForAction)
 	}
 }
 
+// useAction starts the action's cooldown, counts it for the results screen
+// and announces it
+func (m *Manager) useAction(actionType action.ActionType) {
+	m.ActionMgr.UseAction(actionType)
+	m.Stats.RecordAction(actionType)
+
+	for _, a := range m.ActionMgr.Actions {
+		if a.Type == actionType {
+			m.publish(events.Event{Type: events.ActionUsed, Action: a.Name})
+		}
+	}
+}
+
+// chargeHint deducts the configured hint cost from the score
+// Returns the points actually taken, which stops at zero
+func (m *Manager) chargeHint() int {
+	cost := m.Score.Deduct(m.Config.HintCost)
+	m.UIRenderer.Score = m.Score.Points
+	return cost
+}
+
+// startPeek highlights the goal and points the player toward it for a while
+func (m *Manager) startPeek() {
+	if m.Maze.State.SetGoalHighlight(true) {
+		m.peekTimer = PeekGoalFrames
+		m.UIRenderer.MazeOptions.PeekGoal = true
+	}
+}
+
+// updatePeek counts down an active goal peek and clears it when it runs out
+func (m *Manager) updatePeek() {
+	if m.peekTimer <= 0 {
+		return
+	}
+
+	m.peekTimer--
+	if m.peekTimer == 0 {
+		m.Maze.State.SetGoalHighlight(false)
+		m.UIRenderer.MazeOptions.PeekGoal = false
+	}
+}
+
+// swapWithNearestNPC exchanges the player's cell with the closest NPC's
+// Returns false if there was no NPC to swap with
+func (m *Manager) swapWithNearestNPC() bool {
+	playerGridX, playerGridY := m.Player.GetGridPosition()
+	playerPos := maze.Position{X: playerGridX, Y: playerGridY}
+
+	target := m.NPCManager.Nearest(m.Maze, playerPos)
+	if target == nil {
+		return false
+	}
+	npcPos := maze.Position{X: target.GridX, Y: target.GridY}
+
+	// Both cells were occupied, but never land anyone inside a wall
+	if !m.Maze.IsValidMove(playerPos.X, playerPos.Y) || !m.Maze.IsValidMove(npcPos.X, npcPos.Y) {
+		return false
+	}
+
+	m.Player.Teleport(npcPos.X, npcPos.Y, m.Maze.GetTileSize())
+	target.Teleport(playerPos.X, playerPos.Y)
+	m.markExplored(npcPos.X, npcPos.Y)
+	m.recordRoute(npcPos.X, npcPos.Y)
+
+	m.AnimationMgr.Play(m.UIRenderer.NewCellPulse(m.Maze, npcPos))
+	m.AnimationMgr.Play(m.UIRenderer.NewCellPulse(m.Maze, playerPos))
+	return true
+}
+
 // Handle the selected action
 func (m *Manager) handleActionSelection(selectedAction action.Action) {
 	switch selectedAction.Type {
@@ -290,6 +1235,7 @@ func (m *Manager) handleActionSelection(selectedAction action.Action) {
 		m.Maze.HighlightXRotation(playerGridX, playerGridY)
 		m.xRotateActive = true
 		m.xRotateDirection = -1
+		m.showRotationPreview()
 		m.UIRenderer.SetActionMessage("X-Rotate Left? (Confirm: Enter, Cancel: Esc)", 0) // 0 for no timeout
 
 	case action.XRotateRight:
@@ -297,12 +1243,36 @@ func (m *Manager) handleActionSelection(selectedAction action.Action) {
 		m.Maze.HighlightXRotation(playerGridX, playerGridY)
 		m.xRotateActive = true
 		m.xRotateDirection = 1
+		m.showRotationPreview()
 		m.UIRenderer.SetActionMessage("X-Rotate Right? (Confirm: Enter, Cancel: Esc)", 0)
 
+	case action.PeekGoal:
+		m.startPeek()
+		m.useAction(action.PeekGoal)
+		cost := m.chargeHint()
+		m.UIRenderer.ShowMessage(fmt.Sprintf("Peek Goal Used! (-%d points)", cost), 1.5, ui.SuccessMessage)
+		m.Log(fmt.Sprintf("Hint cost %d points", cost))
+		m.TurnManager.NextState(turn.WaitingForEndTurn)
+
+	case action.Swap:
+		if !m.swapWithNearestNPC() {
+			m.UIRenderer.ShowMessage("No NPC to swap with", 1, ui.ErrorMessage)
+			m.TurnManager.NextState(turn.WaitingForAction)
+			return
+		}
+		m.useAction(action.Swap)
+		m.TurnManager.NextState(turn.WaitingForEndTurn)
+
+	case action.ShuffleRow:
+		playerGridX, playerGridY := m.Player.GetGridPosition()
+		m.Maze.HighlightRow(playerGridX, playerGridY)
+		m.shuffleActive = true
+		m.UIRenderer.SetActionMessage("Shuffle Row? (Confirm: Enter, Cancel: Esc)", 0)
+
 	// Add more cases for future actions
 
 	default:
-		m.UIRenderer.SetActionMessage("Unknown action selected", 60)
+		m.UIRenderer.ShowMessage("Unknown action selected", 1, ui.ErrorMessage)
 		m.TurnManager.NextState(turn.WaitingForAction)
 	}
 }
@@ -313,83 +1283,310 @@ func (m *Manager) updatePositions() {
 	playerGridX, playerGridY := m.Player.GetGridPosition()
 
 	// Update player, and check if they've arrived at destination
-	if arrived := m.Player.Update(5.0); arrived {
+	if arrived := m.Player.Update(MoveSpeed, m.frameDelta); arrived {
+		m.publish(events.Event{Type: events.PlayerMoved, X: playerGridX, Y: playerGridY})
+		m.Maze.State.RecordVisit(playerGridX, playerGridY)
+		m.markExplored(playerGridX, playerGridY)
+		m.recordRoute(playerGridX, playerGridY)
+
+		// Teleporters hop the player to the partner cell. Teleporting isn't an
+		// arrival, so the partner never sends them straight back
+		if target, ok := m.Maze.State.TeleportTarget(playerGridX, playerGridY); ok {
+			m.Player.Teleport(target.X, target.Y, m.Maze.GetTileSize())
+			playerGridX, playerGridY = target.X, target.Y
+			m.Maze.State.RecordVisit(playerGridX, playerGridY)
+			m.markExplored(playerGridX, playerGridY)
+			m.recordRoute(playerGridX, playerGridY)
+			m.Log("Player used a teleporter")
+			m.UIRenderer.SetActionMessage("Teleported!", 60)
+		}
 
 		if m.Flavor != nil {
 			playerGridX, playerGridY := m.Player.GetGridPosition()
 			tile := m.Maze.State.GetTile(playerGridX, playerGridY)
 			
 			if tile != nil && tile.Type != maze.Wall {
-				// Get the flavor image path from the tile
-				imagePath := tile.GetFlavorImage()
-				if imagePath != "" {
-					// Update the flavor image based on the tile's assigned image
-					m.Flavor.SetImageByPath(imagePath)
-				}
+				// Show the art for this tile type, or the tile's own image
+				m.Flavor.SetImageForTileType(tile.Type, tile.GetFlavorImage())
 			}
 		}
         
 
+		// Traps send the player back to where their turn started. The
+		// return is a teleport, so it never springs another trap
+		if tile := m.Maze.State.GetTile(playerGridX, playerGridY); tile != nil && tile.Type == maze.Trap {
+			m.UIRenderer.Shake.Start(ui.TrapShakeIntensity)
+			m.Log("Player hit a trap")
+			if m.returnToTurnStart() {
+				playerGridX, playerGridY = m.TurnStartPos.X, m.TurnStartPos.Y
+				m.UIRenderer.ShowMessage("It's a trap! Back to where you started", 1.5, ui.WarningMessage)
+			} else {
+				m.UIRenderer.ShowMessage("It's a trap!", 1.5, ui.WarningMessage)
+			}
+		}
+
 		// Check if player reached the goal
-		if m.Maze.IsGoal(playerGridX, playerGridY) {
-			m.Winner = "Player"
-			m.CurrentState = GameOver
+		if m.goalWins() && m.Maze.IsGoal(playerGridX, playerGridY) {
+			m.finishGame(m.playerWinner(), ui.ReachedGoal)
 			return
 		}
 
-		// DEBUGGING: Skip trivia and go directly to action phase
+		// Ask a question when one is due, otherwise keep moving while steps
+		// are left and then go on to the action phase
 		if m.TurnManager.IsPlayerTurn() && m.TurnManager.CurrentState == turn.WaitingForMove {
-			// Comment out the trivia part
-			// m.CurrentState = AnsweringTrivia
-			// m.TurnManager.NextState(turn.WaitingForTrivia)
-			// m.TriviaMgr.Answered = false
-			// m.TriviaMgr.SetRandomQuestion(rand.Intn)
-
-			// Instead, go directly to waiting for action
-			m.TurnManager.NextState(turn.WaitingForAction)
+			m.spendMove()
+			if m.triviaDue() && m.beginTrivia() {
+				return
+			}
+			m.TurnManager.NextState(m.afterMoveState())
 		}
 	}
 
 	// Update NPCs positions using the manager
-	arrivedNPCs := m.NPCManager.UpdatePositions(5.0)
+	arrivedNPCs := m.NPCManager.UpdatePositions(MoveSpeed, m.frameDelta)
 
 	// Check if any NPCs reached the goal
 	for _, arrivedNPC := range arrivedNPCs {
-		if m.Maze.IsGoal(arrivedNPC.GridX, arrivedNPC.GridY) {
-			m.Winner = fmt.Sprintf("NPC %d", arrivedNPC.ID+1)
-			m.CurrentState = GameOver
+		m.Log(fmt.Sprintf("NPC %d moved", arrivedNPC.ID+1))
+		m.Maze.State.RecordVisit(arrivedNPC.GridX, arrivedNPC.GridY)
+		if target, ok := m.Maze.State.TeleportTarget(arrivedNPC.GridX, arrivedNPC.GridY); ok {
+			arrivedNPC.Teleport(target.X, target.Y)
+			m.Maze.State.RecordVisit(target.X, target.Y)
+			m.Log(fmt.Sprintf("NPC %d used a teleporter", arrivedNPC.ID+1))
+		}
+		if m.goalWins() && m.Maze.IsGoal(arrivedNPC.GridX, arrivedNPC.GridY) {
+			m.finishGame(fmt.Sprintf("NPC %d", arrivedNPC.ID+1), ui.ReachedGoal)
+			return
+		}
+		if m.caughtPlayer(arrivedNPC) {
+			m.Log(fmt.Sprintf("NPC %d caught the player", arrivedNPC.ID+1))
+			m.finishGame(fmt.Sprintf("NPC %d", arrivedNPC.ID+1), ui.CaughtPlayer)
 			return
 		}
 	}
 }
 
+// caughtPlayer checks if an NPC has landed on a human's cell while NPCs
+// are chasing. The sandbox has no player to catch
+func (m *Manager) caughtPlayer(n *npc.NPC) bool {
+	if !m.chasing() {
+		return false
+	}
+	for _, human := range m.humanPlayers() {
+		if humanX, humanY := human.GetGridPosition(); n.GridX == humanX && n.GridY == humanY {
+			return true
+		}
+	}
+	return false
+}
+
+// markExplored counts the player's first arrival on a tile toward the exploration meter
+func (m *Manager) markExplored(x, y int) {
+	if m.Maze.State.MarkExplored(x, y) {
+		m.exploredTiles++
+		m.UIRenderer.ExploredPercent = m.ExploredPercent()
+	}
+}
+
+// NearestUnexplored returns the closest floor tile the player hasn't visited,
+// by walking distance from the player's tile.
+// Returns false once everything the player can reach has been explored
+func (m *Manager) NearestUnexplored() (maze.Position, bool) {
+	x, y := m.Player.GetGridPosition()
+	return m.Maze.State.NearestUnexplored(maze.Position{X: x, Y: y})
+}
+
+// updateExploreHint points the renderer at the nearest unexplored tile
+// while the explore hint is turned on
+func (m *Manager) updateExploreHint() {
+	m.UIRenderer.MazeOptions.Unexplored = nil
+	if !m.Config.ExploreHint || m.Sandbox {
+		return
+	}
+	if target, ok := m.NearestUnexplored(); ok {
+		m.UIRenderer.MazeOptions.Unexplored = &target
+	}
+}
+
+// ExploredPercent returns how much of the maze's floor the player has visited (0-100)
+func (m *Manager) ExploredPercent() float64 {
+	if m.floorTiles == 0 {
+		return 0
+	}
+	percent := float64(m.exploredTiles) / float64(m.floorTiles) * 100
+	if percent > 100 {
+		percent = 100
+	}
+	return percent
+}
+
+// finishGame records the winner and how they won, switches to the game
+// over screen and plays the celebration
+func (m *Manager) finishGame(winner string, reason ui.WinReason) {
+	m.publish(events.Event{Type: events.GameWon, Winner: winner})
+	m.Winner = winner
+	m.WinReason = reason
+	m.CurrentState = GameOver
+	m.Stats.Elapsed = m.Clock.Since(m.matchStart)
+	m.AnimationMgr.Play(m.UIRenderer.NewCelebration())
+	m.clearSave()
+
+	// Only real player wins count toward high scores
+	if winner == m.playerWinner() && !m.Demo.Active && !m.Practice {
+		m.recordResult()
+	}
+
+	// Keep the trivia answers for studying afterwards
+	if path := m.Config.TriviaSummaryPath; path != "" && len(m.TriviaMgr.SessionSummary()) > 0 {
+		if err := m.TriviaMgr.WriteSessionSummary(path); err != nil {
+			m.Logger.Warn("failed to save trivia summary", "err", err)
+		}
+	}
+	if path := m.Config.TriviaStatsPath; path != "" {
+		if err := m.TriviaMgr.SaveStats(path); err != nil {
+			m.Logger.Warn("failed to save trivia stats", "err", err)
+		}
+	}
+}
+
+// triviaRecap formats each answered question for the game over screen
+func triviaRecap(results []trivia.Result) []string {
+	lines := make([]string, 0, len(results))
+	for _, result := range results {
+		if result.Correct {
+			lines = append(lines, fmt.Sprintf("+ %s %s", result.Question, result.Answer))
+		} else {
+			lines = append(lines, fmt.Sprintf("- %s %s (not %s)", result.Question, result.Answer, result.Choice))
+		}
+	}
+	return lines
+}
+
+// recordResult submits the finished run to the high score table
+func (m *Manager) recordResult() {
+	record := highscore.Record{
+		Turns:   m.TurnManager.TurnNumber,
+		Seconds: m.Stats.Elapsed.Seconds(),
+	}
+
+	m.NewRecord = m.HighScores.Submit(m.scoreCategory(), record)
+	if m.NewRecord {
+		if err := highscore.Save(highscore.DefaultPath, m.HighScores); err != nil {
+			m.Logger.Warn("failed to save high scores", "err", err)
+		}
+	}
+}
+
+// scoreCategory groups high scores by maze size
+func (m *Manager) scoreCategory() string {
+	return highscore.Category(m.Maze.State.Width, m.Maze.State.Height)
+}
+
+// GameOverInfo summarizes the finished match for the game over screen
+func (m *Manager) GameOverInfo() ui.GameOverInfo {
+	info := ui.GameOverInfo{
+		Winner:        m.Winner,
+		Reason:        m.WinReason,
+		NewRecord:     m.NewRecord,
+		Turns:         m.TurnManager.TurnNumber,
+		PlayerMoves:   m.Stats.PlayerMoves,
+		ActionsUsed:   m.Stats.ActionSummary(m.ActionMgr.Actions),
+		TriviaCorrect: m.Stats.TriviaCorrect,
+		TriviaTotal:   m.Stats.TriviaTotal,
+		TriviaRecap:   triviaRecap(m.TriviaMgr.SessionSummary()),
+		Score:         m.Score.Points,
+		Elapsed:       m.Stats.Elapsed,
+		Route:         m.Route(),
+	}
+
+	if best, ok := m.HighScores.Lookup(m.scoreCategory()); ok {
+		info.BestRecord = fmt.Sprintf("%d turns in %.1fs (%s)", best.Turns, best.Seconds, m.scoreCategory())
+	}
+
+	return info
+}
+
+// beginPlayerTurn remembers where the player stands as their turn starts
+func (m *Manager) beginPlayerTurn() {
+	m.activateHuman()
+	playerGridX, playerGridY := m.Player.GetGridPosition()
+	m.TurnStartPos = maze.Position{X: playerGridX, Y: playerGridY}
+	m.previousPos = m.TurnStartPos
+	m.actionForfeited = false
+	m.resetMoves()
+}
+
+// returnToTurnStart teleports the player back to their turn-start cell
+// Returns false if the player is already there
+func (m *Manager) returnToTurnStart() bool {
+	playerGridX, playerGridY := m.Player.GetGridPosition()
+	if m.TurnStartPos.X == playerGridX && m.TurnStartPos.Y == playerGridY {
+		return false
+	}
+
+	m.Player.Teleport(m.TurnStartPos.X, m.TurnStartPos.Y, m.Maze.GetTileSize())
+	m.AnimationMgr.Play(m.UIRenderer.NewCellPulse(m.Maze, m.TurnStartPos))
+	return true
+}
+
 // Handle player movement
 func (m *Manager) handlePlayerMovement() {
 	if m.Player.IsMoving() {
 		return // Already moving
 	}
 
-	playerGridX, playerGridY := m.Player.GetGridPosition()
-	dx, dy := m.InputHandler.CheckPlayerMovement()
+	dx, dy := m.moveSource().NextMove()
 
 	if dx == 0 && dy == 0 {
 		return // No movement input
 	}
 
+	m.movePlayer(dx, dy)
+}
+
+// movePlayer starts a step of the player in the given direction.
+// Returns false if the step is blocked by a wall or the edge of the maze
+func (m *Manager) movePlayer(dx, dy int) bool {
+	playerGridX, playerGridY := m.Player.GetGridPosition()
 	newGridX, newGridY := playerGridX+dx, playerGridY+dy
 
 	// Check if movement is valid (not a wall and within bounds)
-	if m.Maze.IsValidMove(newGridX, newGridY) {
-		// Set destination for smooth movement
-		m.Player.SetDestination(newGridX, newGridY, maze.TileSize)
+	if !m.Maze.IsValidMove(newGridX, newGridY) {
+		return false
+	}
+
+	// Set destination for smooth movement, remembering where the step
+	// started in case a wrong answer pushes the player back
+	m.previousPos = maze.Position{X: playerGridX, Y: playerGridY}
+	m.Player.SetDestination(newGridX, newGridY, m.Maze.GetTileSize())
+	m.Stats.RecordMove()
+
+	// An instant step has nothing to slide, handle the arrival this frame
+	if m.Player.Instant {
+		m.updatePositions()
 	}
+	return true
 }
 
 // Process NPC turn using the NPC manager
 func (m *Manager) processNPCTurn() {
 	// Check if all NPCs have moved
 	if m.NPCManager.AllMoved() {
+		m.NPCManager.Acting = nil
 		m.TurnManager.EndTurn() // Switch back to player's turn
+		m.publish(events.Event{Type: events.TurnChanged, PlayerTurn: m.TurnManager.IsPlayerTurn()})
+		if m.TurnManager.IsPlayerTurn() {
+			if m.shrinkDue() {
+				m.shrinkMaze()
+				if m.CurrentState == GameOver {
+					return
+				}
+			}
+			m.beginPlayerTurn()
+		} else {
+			// All-NPC rotation, start the next round straight away
+			m.NPCManager.ResetMovedStatus()
+		}
 		return
 	}
 
@@ -398,22 +1595,108 @@ func (m *Manager) processNPCTurn() {
 		return m.Maze.IsValidMove(x, y)
 	}
 
-	m.NPCManager.ProcessTurn(validMoveFn)
+	// Pause between NPCs so each move can be followed
+	if m.npcDelay > 0 {
+		m.npcDelay--
+		return
+	}
+
+	playerGridX, playerGridY := m.Player.GetGridPosition()
+	if m.NPCManager.ProcessTurn(m.Maze, maze.Position{X: playerGridX, Y: playerGridY}, validMoveFn) {
+		m.npcDelay = m.Config.NPCDelayFrames
+		if m.Config.InstantMovement {
+			m.updatePositions()
+		}
+	}
+
+	// Let the player know when an NPC rotates the maze against them
+	if rotation := m.NPCManager.LastRotation; rotation != nil {
+		directionName := "Right"
+		if rotation.Direction < 0 {
+			directionName = "Left"
+		}
+		m.UIRenderer.ShowMessage(fmt.Sprintf("NPC %d rotated its row %s!", rotation.NPCID+1, directionName), 1.5, ui.WarningMessage)
+		m.Log(fmt.Sprintf("NPC %d X-Rotate %s", rotation.NPCID+1, directionName))
+	}
+}
+
+// triviaDue counts a player arrival and checks if it is the one that earns
+// a trivia question under the TriviaFrequency setting. A trivia score match
+// asks on every arrival
+func (m *Manager) triviaDue() bool {
+	if m.Demo.Active || m.Practice {
+		return false
+	}
+
+	// Points are the only way to win a trivia score match, so every move asks
+	if m.scoreRace() {
+		return true
+	}
+	if m.Config.TriviaFrequency <= 0 {
+		return false
+	}
+
+	m.movesSinceTrivia++
+	if m.movesSinceTrivia < m.Config.TriviaFrequency {
+		return false
+	}
+	m.movesSinceTrivia = 0
+	return true
+}
+
+// beginTrivia switches to the trivia phase with a random question.
+// Returns false, leaving the turn alone, if there is no question to ask
+func (m *Manager) beginTrivia() bool {
+	if !m.TriviaMgr.SetRandomQuestion(m.Maze.Rand.Intn) {
+		m.Logger.Warn("no trivia questions available, skipping trivia")
+		return false
+	}
+	m.CurrentState = AnsweringTrivia
+	m.TurnManager.NextState(turn.WaitingForTrivia)
+	return true
+}
+
+// updateTriviaResult keeps the answered question's result up until a key
+// is pressed or the delay runs out
+func (m *Manager) updateTriviaResult(keyPressed bool) {
+	if keyPressed || m.Clock.Since(m.triviaAnsweredAt) >= m.Config.TriviaResultDelay {
+		m.leaveTrivia()
+	}
 }
 
 // Update trivia state
 func (m *Manager) updateTrivia() {
+	if m.TriviaMgr.Answered {
+		m.updateTriviaResult(m.InputHandler.AnyKeyPressed())
+		return
+	}
+
+	// With nothing to ask, skip straight to the action phase
+	question, ok := m.TriviaMgr.GetCurrentQuestion()
+	if !ok {
+		m.Logger.Warn("no trivia question available, skipping trivia")
+		m.CurrentState = Playing
+		m.TurnManager.NextState(m.afterMoveState())
+		return
+	}
+
 	// Get input from the input handler
-	answer := m.InputHandler.CheckTriviaInput()
+	answer := m.InputHandler.CheckTriviaInput(question.OptionCount())
 
 	if answer > 0 {
 		// Process the answer
 		correct := m.TriviaMgr.CheckAnswer(answer - 1) // Convert from 1-based to 0-based
 		m.TriviaMgr.Answered = true
 		m.TriviaMgr.Correct = correct
-
-		// Return to game after brief delay
-		m.CurrentState = Playing
-		m.TurnManager.NextState(turn.WaitingForAction)
+		m.triviaAnsweredAt = m.Clock.Now()
+		m.Stats.RecordTrivia(correct)
+		if correct {
+			m.Score.Add(score.TriviaPoints)
+			m.UIRenderer.Score = m.Score.Points
+			m.Log(fmt.Sprintf("Correct answer (+%d)", score.TriviaPoints))
+		} else {
+			m.Log("Incorrect answer")
+			m.UIRenderer.Shake.Start(ui.PenaltyShakeIntensity)
+		}
 	}
 }
@@ -0,0 +1,973 @@
+// internal/game/state/state_test.go
+package state
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/JacobCromwell/Mazenasium/internal/game/action"
+	"github.com/JacobCromwell/Mazenasium/internal/game/clock"
+	"github.com/JacobCromwell/Mazenasium/internal/game/config"
+	"github.com/JacobCromwell/Mazenasium/internal/game/logging"
+	"github.com/JacobCromwell/Mazenasium/internal/game/maze"
+	"github.com/JacobCromwell/Mazenasium/internal/game/menu"
+	"github.com/JacobCromwell/Mazenasium/internal/game/npc"
+	"github.com/JacobCromwell/Mazenasium/internal/game/turn"
+	"github.com/JacobCromwell/Mazenasium/internal/game/ui"
+)
+
+// newTestManager creates a seeded manager timed by a manual clock.
+// It runs in a scratch directory so saves and high scores never touch
+// the real files
+func newTestManager(t *testing.T, cfg config.Config) (*Manager, *clock.ManualClock) {
+	t.Helper()
+	t.Chdir(t.TempDir())
+
+	if cfg.Seed == 0 {
+		cfg.Seed = 1
+	}
+	m := NewWithConfig(cfg)
+	m.Logger = logging.Nop{}
+	m.Flavor.Logger = logging.Nop{}
+
+	clk := clock.NewManualClock(time.Unix(0, 0))
+	m.SetClock(clk)
+	return m, clk
+}
+
+func TestRestartIgnoredUntilCelebrationFinishes(t *testing.T) {
+	m, clk := newTestManager(t, config.Default())
+	m.startMatch()
+	m.finishGame("NPC 1", ui.ReachedGoal)
+
+	m.updateGameOver(true)
+	if m.CurrentState != GameOver {
+		t.Fatalf("restart accepted while the celebration plays, state %v", m.CurrentState)
+	}
+
+	clk.Advance(2 * time.Second)
+	m.AnimationMgr.Update()
+	if m.AnimationMgr.IsPlaying() {
+		t.Fatal("celebration should have finished after 2s")
+	}
+
+	m.updateGameOver(false)
+	if m.CurrentState != GameOver {
+		t.Fatal("game over should wait for the restart key")
+	}
+
+	m.updateGameOver(true)
+	if m.CurrentState != Menu {
+		t.Errorf("state after restart = %v, want Menu", m.CurrentState)
+	}
+}
+
+// openNeighbour returns a direction from the player to a plain floor cell
+func openNeighbour(t *testing.T, m *Manager) (int, int) {
+	t.Helper()
+	x, y := m.Player.GetGridPosition()
+	for _, d := range [][2]int{{1, 0}, {0, 1}, {-1, 0}, {0, -1}} {
+		if tile := m.Maze.State.GetTile(x+d[0], y+d[1]); tile != nil && tile.Type == maze.Floor {
+			return d[0], d[1]
+		}
+	}
+	t.Fatalf("no plain floor next to the player at (%d,%d)", x, y)
+	return 0, 0
+}
+
+func TestPlayerArrivalRecordsVisit(t *testing.T) {
+	cfg := config.Default()
+	cfg.InstantMovement = true
+	m, _ := newTestManager(t, cfg)
+	m.startMatch()
+
+	dx, dy := openNeighbour(t, m)
+	x, y := m.Player.GetGridPosition()
+	before := m.Maze.State.GetTile(x+dx, y+dy).VisitCount
+
+	if !m.movePlayer(dx, dy) {
+		t.Fatal("the step onto open floor was refused")
+	}
+	if got := m.Maze.State.GetTile(x+dx, y+dy).VisitCount; got != before+1 {
+		t.Errorf("VisitCount = %d after arriving, want %d", got, before+1)
+	}
+}
+
+func TestQuitNoKeepsGameRunning(t *testing.T) {
+	m, _ := newTestManager(t, config.Default())
+
+	// Choosing No navigates back inside the menu and returns no action
+	m.handleMenuAction("")
+	if m.ShouldExit || m.CurrentState != Menu {
+		t.Errorf("after No: ShouldExit %v, state %v", m.ShouldExit, m.CurrentState)
+	}
+
+	m.handleMenuAction("quit")
+	if !m.ShouldExit {
+		t.Error("confirming quit should ask the game loop to stop")
+	}
+}
+
+func TestQuitHookSurvivesReset(t *testing.T) {
+	m, _ := newTestManager(t, config.Default())
+	calls := 0
+	m.OnQuit = func() { calls++ }
+
+	m.reset()
+	m.handleMenuAction("quit")
+
+	if calls != 1 {
+		t.Errorf("OnQuit called %d times, want 1", calls)
+	}
+	if !m.ShouldExit {
+		t.Error("ShouldExit should be set alongside the hook")
+	}
+}
+
+func TestNPCRotationsAreOptIn(t *testing.T) {
+	m, _ := newTestManager(t, config.Default())
+	if m.NPCManager.RotateChance != 0 {
+		t.Fatalf("RotateChance = %v by default, want 0", m.NPCManager.RotateChance)
+	}
+
+	m.handleMenuAction("cycle_npc_rotate")
+	if m.NPCManager.RotateChance <= 0 || m.NPCManager.RotateChance != m.Config.NPCRotateChance {
+		t.Errorf("RotateChance = %v after choosing %v", m.NPCManager.RotateChance, m.Config.NPCRotateChance)
+	}
+}
+
+func TestTurnStartCapturedAtStartOfTurn(t *testing.T) {
+	cfg := config.Default()
+	cfg.InstantMovement = true
+	m, _ := newTestManager(t, cfg)
+	m.startMatch()
+	m.beginPlayerTurn()
+
+	x, y := m.Player.GetGridPosition()
+	if m.TurnStartPos != (maze.Position{X: x, Y: y}) {
+		t.Fatalf("TurnStartPos = %v, want the player's cell (%d,%d)", m.TurnStartPos, x, y)
+	}
+
+	dx, dy := openNeighbour(t, m)
+	m.movePlayer(dx, dy)
+	if m.TurnStartPos != (maze.Position{X: x, Y: y}) {
+		t.Errorf("TurnStartPos moved to %v during the turn", m.TurnStartPos)
+	}
+
+	m.beginPlayerTurn()
+	if m.TurnStartPos != (maze.Position{X: x + dx, Y: y + dy}) {
+		t.Errorf("TurnStartPos = %v on the next turn, want (%d,%d)", m.TurnStartPos, x+dx, y+dy)
+	}
+}
+
+func TestTrapReturnsPlayerToTurnStartOnce(t *testing.T) {
+	cfg := config.Default()
+	cfg.InstantMovement = true
+	m, _ := newTestManager(t, cfg)
+	m.startMatch()
+	m.beginPlayerTurn()
+
+	start := m.TurnStartPos
+	dx, dy := openNeighbour(t, m)
+	trap := maze.Position{X: start.X + dx, Y: start.Y + dy}
+	m.Maze.State.SetTileType(trap.X, trap.Y, maze.Trap)
+	startVisits := m.Maze.State.GetTile(start.X, start.Y).VisitCount
+
+	if !m.movePlayer(dx, dy) {
+		t.Fatal("the step onto the trap was refused")
+	}
+	if x, y := m.Player.GetGridPosition(); x != start.X || y != start.Y {
+		t.Fatalf("player at (%d,%d) after the trap, want the turn start %v", x, y, start)
+	}
+
+	// The return is a teleport, so later frames see no second arrival
+	m.updatePositions()
+	m.updatePositions()
+	if got := m.Maze.State.GetTile(trap.X, trap.Y).VisitCount; got != 1 {
+		t.Errorf("trap visited %d times, want 1", got)
+	}
+	if got := m.Maze.State.GetTile(start.X, start.Y).VisitCount; got != startVisits {
+		t.Errorf("turn start visited %d times after the return, want %d", got, startVisits)
+	}
+	if m.Player.IsMoving() {
+		t.Error("the player should rest on the turn start")
+	}
+}
+
+func TestNPCsStepOrthogonallyUnlessMixedMovesChosen(t *testing.T) {
+	m, _ := newTestManager(t, config.Default())
+	if len(m.NPCManager.NPCs) < 2 {
+		t.Fatalf("only %d NPCs spawned, want at least 2", len(m.NPCManager.NPCs))
+	}
+	for _, n := range m.NPCManager.NPCs {
+		if n.Pattern != npc.Orthogonal {
+			t.Errorf("NPC %d moves %v by default, want Orthogonal", n.ID, n.Pattern)
+		}
+	}
+
+	m.handleMenuAction("toggle_npc_patterns")
+	for i, n := range m.NPCManager.NPCs {
+		if want := mixedPatterns[i%len(mixedPatterns)]; n.Pattern != want {
+			t.Errorf("NPC %d moves %v with mixed moves on, want %v", n.ID, n.Pattern, want)
+		}
+	}
+}
+
+func TestPeekHighlightsGoalUntilItRunsOut(t *testing.T) {
+	m, _ := newTestManager(t, config.Default())
+	m.startMatch()
+	goal, ok := m.Maze.State.FindGoal()
+	if !ok {
+		t.Fatal("the generated maze has no goal")
+	}
+	highlighted := func() bool { return m.Maze.State.Grid[goal.Y][goal.X].Highlighted }
+
+	m.startPeek()
+	if !highlighted() || !m.UIRenderer.MazeOptions.PeekGoal {
+		t.Fatal("peeking should highlight the goal")
+	}
+
+	for frame := 1; frame < PeekGoalFrames; frame++ {
+		m.updatePeek()
+	}
+	if !highlighted() {
+		t.Fatal("the highlight cleared before the peek ran out")
+	}
+
+	m.updatePeek()
+	if highlighted() || m.UIRenderer.MazeOptions.PeekGoal {
+		t.Errorf("the highlight is still shown %d frames after peeking", PeekGoalFrames)
+	}
+}
+
+func TestExploredPercentGrowsFromZero(t *testing.T) {
+	m, _ := newTestManager(t, config.Default())
+	if got, want := m.ExploredPercent(), 100/float64(m.floorTiles); got != want {
+		t.Fatalf("ExploredPercent() = %v at the start, want only the start cell's %v", got, want)
+	}
+
+	// Forget the start cell so the meter begins from nothing
+	start := m.Maze.State.Start
+	m.Maze.State.Grid[start.Y][start.X].Visited = false
+	m.exploredTiles = 0
+	if got := m.ExploredPercent(); got != 0 {
+		t.Fatalf("ExploredPercent() = %v with nothing explored, want 0", got)
+	}
+
+	floor := 0
+	previous := 0.0
+	for y := 0; y < m.Maze.State.Height; y++ {
+		for x := 0; x < m.Maze.State.Width; x++ {
+			if m.Maze.State.Grid[y][x].IsWall() {
+				continue
+			}
+			floor++
+			m.markExplored(x, y)
+			m.markExplored(x, y) // A second visit doesn't count again
+
+			got := m.ExploredPercent()
+			if got < previous {
+				t.Fatalf("ExploredPercent() fell from %v to %v", previous, got)
+			}
+			if want := float64(floor) / float64(m.floorTiles) * 100; got != want {
+				t.Fatalf("ExploredPercent() = %v after %d tiles, want %v", got, floor, want)
+			}
+			previous = got
+		}
+	}
+
+	if previous != 100 {
+		t.Errorf("ExploredPercent() = %v with every tile visited, want 100", previous)
+	}
+}
+
+// gridTypes copies the tile types of the current maze
+func gridTypes(m *Manager) [][]maze.TileType {
+	types := make([][]maze.TileType, m.Maze.State.Height)
+	for y := range types {
+		types[y] = make([]maze.TileType, m.Maze.State.Width)
+		for x := range types[y] {
+			types[y][x] = m.Maze.State.Grid[y][x].Type
+		}
+	}
+	return types
+}
+
+// sameGrid reports whether two grids hold the same tile types
+func sameGrid(a, b [][]maze.TileType) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for y := range a {
+		if len(a[y]) != len(b[y]) {
+			return false
+		}
+		for x := range a[y] {
+			if a[y][x] != b[y][x] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestTypedSeedReproducesMaze(t *testing.T) {
+	m, _ := newTestManager(t, config.Default())
+	m.MenuMgr.SetItemValue("set_seed", "4242")
+	m.handleMenuAction("set_seed")
+	if m.Config.Seed != 4242 {
+		t.Fatalf("Config.Seed = %d, want 4242", m.Config.Seed)
+	}
+	first := gridTypes(m)
+
+	m.handleMenuAction("reroll_maze")
+	if !sameGrid(first, gridTypes(m)) {
+		t.Error("rerolling with a seed set should rebuild the same maze")
+	}
+
+	cfg := config.Default()
+	cfg.Seed = 4242
+	other, _ := newTestManager(t, cfg)
+	if !sameGrid(first, gridTypes(other)) {
+		t.Error("a new game with the same seed should build the same maze")
+	}
+}
+
+func TestSettingsKeepTheTypedSeed(t *testing.T) {
+	m, _ := newTestManager(t, config.Default())
+	m.MenuMgr.SetItemValue("set_seed", "77")
+
+	m.handleMenuAction("toggle_shake")
+	if got := m.MenuMgr.ItemValue("set_seed"); got != "77" {
+		t.Errorf("seed field = %q after changing another setting, want the typed %q", got, "77")
+	}
+}
+
+func TestInvalidSeedKeepsTheCurrentMaze(t *testing.T) {
+	m, _ := newTestManager(t, config.Default())
+	seed, before := m.Config.Seed, gridTypes(m)
+	m.MenuMgr.SetItemValue("set_seed", "12x")
+
+	m.handleMenuAction("set_seed")
+	if m.Config.Seed != seed {
+		t.Errorf("Config.Seed = %d after an invalid seed, want %d", m.Config.Seed, seed)
+	}
+	if !sameGrid(before, gridTypes(m)) {
+		t.Error("an invalid seed shouldn't rebuild the maze")
+	}
+}
+
+func TestNPCMovesSettingUpdatesExistingNPCs(t *testing.T) {
+	m, _ := newTestManager(t, config.Default())
+	before := m.Maze
+
+	m.handleMenuAction("cycle_npc_moves")
+	if m.Config.NPCMovesPerTurn != 2 {
+		t.Fatalf("NPCMovesPerTurn = %d, want 2", m.Config.NPCMovesPerTurn)
+	}
+	for _, n := range m.NPCManager.NPCs {
+		if n.MovesPerTurn != 2 {
+			t.Errorf("NPC %d takes %d moves, want 2", n.ID, n.MovesPerTurn)
+		}
+	}
+	if m.Maze != before {
+		t.Error("changing NPC moves shouldn't rebuild the maze")
+	}
+}
+
+func TestHighContrastSettingReachesRenderer(t *testing.T) {
+	m, _ := newTestManager(t, config.Default())
+	if m.UIRenderer.HighContrast {
+		t.Fatal("high contrast should be off by default")
+	}
+
+	m.handleMenuAction("toggle_contrast")
+	if !m.UIRenderer.HighContrast {
+		t.Error("turning high contrast on should reach the renderer")
+	}
+
+	// A second manager keeps its own setting
+	other, _ := newTestManager(t, config.Default())
+	if other.UIRenderer.HighContrast {
+		t.Error("one manager's high contrast leaked into another")
+	}
+}
+
+func TestScreenSizeComesFromTheConfig(t *testing.T) {
+	defer ui.SetScreenSize(ui.DefaultScreenWidth, ui.DefaultScreenHeight)
+	cfg := config.Default()
+	cfg.ScreenWidth, cfg.ScreenHeight = 1, ui.MaxScreenHeight*2
+	m, _ := newTestManager(t, cfg)
+
+	if m.Config.ScreenWidth != ui.MinScreenWidth || m.Config.ScreenHeight != ui.MaxScreenHeight {
+		t.Errorf("config size %dx%d, want it clamped to %dx%d",
+			m.Config.ScreenWidth, m.Config.ScreenHeight, ui.MinScreenWidth, ui.MaxScreenHeight)
+	}
+	m.reset()
+	if ui.ScreenWidth != ui.MinScreenWidth || ui.ScreenHeight != ui.MaxScreenHeight {
+		t.Errorf("screen %dx%d after a reset, want %dx%d",
+			ui.ScreenWidth, ui.ScreenHeight, ui.MinScreenWidth, ui.MaxScreenHeight)
+	}
+}
+
+func TestDebugViewsClearWhenDebugKeysTurnOff(t *testing.T) {
+	cfg := config.Default()
+	cfg.DebugKeys = true
+	m, _ := newTestManager(t, cfg)
+	m.UIRenderer.ToggleReveal()
+	m.UIRenderer.ToggleHeatmap()
+	m.UIRenderer.ToggleCoordinates()
+
+	m.handleMenuAction("toggle_debug")
+	opts := m.UIRenderer.MazeOptions
+	if opts.Reveal || opts.Heatmap || opts.Coordinates {
+		t.Errorf("debug views should clear with debug keys off, got reveal %v heatmap %v coordinates %v",
+			opts.Reveal, opts.Heatmap, opts.Coordinates)
+	}
+}
+
+// openPlayerRow turns the interior of the player's row into floor, with a
+// wall at wallX unless it is 0, and returns the row
+func openPlayerRow(m *Manager, wallX int) int {
+	_, y := m.Player.GetGridPosition()
+	state := m.Maze.State
+	for x := state.Border; x < state.Width-state.Border; x++ {
+		state.SetTileType(x, y, maze.Floor)
+	}
+	if wallX > 0 {
+		state.SetTileType(wallX, y, maze.Wall)
+	}
+	return y
+}
+
+func TestXRotateCooldownMatrix(t *testing.T) {
+	tests := []struct {
+		name         string
+		wallX        int // Wall in the player's row, rotated left onto the NPC on x=3
+		cancel       bool
+		wantCooldown bool
+	}{
+		{name: "cancel", cancel: true},
+		{name: "collision", wallX: 4},
+		{name: "success", wantCooldown: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, _ := newTestManager(t, config.Default())
+			m.startMatch()
+			m.Player.Teleport(1, 1, m.Maze.GetTileSize())
+			y := openPlayerRow(m, tt.wallX)
+			m.NPCManager.NPCs[0].Teleport(3, y)
+			collides := m.Maze.CheckXRotateCollisions(1, y, -1, m.collectEntityPositions())
+			if collides != (tt.wallX > 0) {
+				t.Fatalf("rotation collides = %v, want %v", collides, tt.wallX > 0)
+			}
+
+			m.handleActionSelection(action.Action{Type: action.XRotateLeft})
+			if tt.cancel {
+				m.cancelXRotate()
+			} else {
+				m.confirmXRotate()
+			}
+
+			onCooldown := m.ActionMgr.Cooldowns[action.XRotateLeft] > 0
+			if onCooldown != tt.wantCooldown {
+				t.Errorf("on cooldown = %v, want %v", onCooldown, tt.wantCooldown)
+			}
+			if m.xRotateActive {
+				t.Error("X-rotate mode should have ended")
+			}
+		})
+	}
+}
+
+func TestSwapTradesPlacesWithNearestNPC(t *testing.T) {
+	m, _ := newTestManager(t, config.Default())
+	m.startMatch()
+	m.Player.Teleport(1, 1, m.Maze.GetTileSize())
+	y := openPlayerRow(m, 0)
+	if len(m.NPCManager.NPCs) < 2 {
+		t.Fatalf("only %d NPCs spawned, want at least 2", len(m.NPCManager.NPCs))
+	}
+	far, near := m.NPCManager.NPCs[0], m.NPCManager.NPCs[1]
+	far.Teleport(5, y)
+	near.Teleport(3, y)
+
+	if !m.swapWithNearestNPC() {
+		t.Fatal("swap refused with NPCs in reach")
+	}
+
+	if x, py := m.Player.GetGridPosition(); x != 3 || py != y {
+		t.Errorf("player at (%d,%d), want the nearest NPC's cell (3,%d)", x, py, y)
+	}
+	if near.GridX != 1 || near.GridY != 1 {
+		t.Errorf("nearest NPC at (%d,%d), want the player's cell (1,1)", near.GridX, near.GridY)
+	}
+	if far.GridX != 5 || far.GridY != y {
+		t.Errorf("the farther NPC moved to (%d,%d)", far.GridX, far.GridY)
+	}
+}
+
+func TestTriviaResultWaitsForKeyOrTimeout(t *testing.T) {
+	for _, byKey := range []bool{true, false} {
+		cfg := config.Default()
+		cfg.TriviaResultDelay = 3 * time.Second
+		m, clk := newTestManager(t, cfg)
+		m.startMatch()
+		m.CurrentState = AnsweringTrivia
+		m.TriviaMgr.Answered, m.TriviaMgr.Correct = true, true
+		m.triviaAnsweredAt = clk.Now()
+
+		clk.Advance(2 * time.Second)
+		m.updateTriviaResult(false)
+		if m.CurrentState != AnsweringTrivia {
+			t.Fatalf("the result closed after 2s of a 3s delay without a key press")
+		}
+
+		if byKey {
+			m.updateTriviaResult(true)
+		} else {
+			clk.Advance(time.Second)
+			m.updateTriviaResult(false)
+		}
+		if m.CurrentState != Playing {
+			t.Errorf("key press %v: state %v, want play to carry on", byKey, m.CurrentState)
+		}
+	}
+}
+
+func TestPeekGoalChargesTheHintCost(t *testing.T) {
+	cfg := config.Default()
+	cfg.HintCost = 3
+	m, _ := newTestManager(t, cfg)
+	m.startMatch()
+	m.Score.Add(5)
+	peek := action.Action{Type: action.PeekGoal, Name: "Peek Goal"}
+
+	m.handleActionSelection(peek)
+	if m.Score.Points != 2 || m.UIRenderer.Score != 2 {
+		t.Fatalf("score %d (shown %d) after a hint from 5, want 2", m.Score.Points, m.UIRenderer.Score)
+	}
+
+	m.handleActionSelection(peek)
+	if m.Score.Points != 0 || m.UIRenderer.Score != 0 {
+		t.Errorf("score %d (shown %d) after a hint from 2, want it clamped at 0", m.Score.Points, m.UIRenderer.Score)
+	}
+}
+
+// useGrid replaces the match's maze with rows of '#' (wall), '.' (floor)
+// and 'G' (goal)
+func useGrid(m *Manager, rows ...string) {
+	state := maze.NewState(len(rows[0]), len(rows))
+	for y, row := range rows {
+		for x, c := range row {
+			switch c {
+			case '.':
+				state.SetTileType(x, y, maze.Floor)
+			case 'G':
+				state.SetTileType(x, y, maze.Goal)
+				state.GoalX, state.GoalY = x, y
+			}
+		}
+	}
+	m.Maze.State = state
+}
+
+func TestRotationCuttingOffAnNPCIsReverted(t *testing.T) {
+	m, _ := newTestManager(t, config.Default())
+	m.startMatch()
+	// The NPC on row 3 only reaches the goal through (4,2). Rotating the
+	// player's row right moves the wall from (3,1) onto (4,1), while the
+	// player and the carved start keep their way to the goal below them
+	useGrid(m,
+		"#########",
+		"#..#....#",
+		"#.##.##G#",
+		"#......##",
+		"#########",
+	)
+	m.Maze.State.Start = maze.Position{X: 7, Y: 1}
+	m.Player.Teleport(7, 1, m.Maze.GetTileSize())
+	m.NPCManager.NPCs = m.NPCManager.NPCs[:1]
+	m.NPCManager.NPCs[0].Teleport(3, 3)
+	before := gridTypes(m)
+
+	m.handleActionSelection(action.Action{Type: action.XRotateRight})
+	m.confirmXRotate()
+
+	if !sameGrid(gridTypes(m), before) {
+		t.Error("the rotation cut the NPC off from the goal but was kept")
+	}
+	if m.ActionMgr.Cooldowns[action.XRotateRight] > 0 {
+		t.Error("a reverted rotation shouldn't spend the action")
+	}
+	if !m.Maze.CanReachGoal(maze.Position{X: 3, Y: 3}) {
+		t.Error("the NPC can't reach the goal after the revert")
+	}
+}
+
+func TestFrameDeltaFollowsTheClock(t *testing.T) {
+	m, clk := newTestManager(t, config.Default())
+
+	// The first update assumes a regular frame
+	if dt := m.tick(clk.Now()); dt != 1.0/60 {
+		t.Errorf("first frame delta = %v, want 1/60", dt)
+	}
+
+	total := 0.0
+	for frame := 0; frame < 30; frame++ {
+		clk.Advance(time.Second / 30)
+		total += m.tick(clk.Now())
+	}
+	if total < 0.999 || total > 1.001 {
+		t.Errorf("30 updates over one second sum to %vs", total)
+	}
+
+	clk.Advance(5 * time.Second)
+	if dt := m.tick(clk.Now()); dt != MaxFrameDelta {
+		t.Errorf("a stalled frame simulated %vs, want the %vs cap", dt, MaxFrameDelta)
+	}
+}
+
+func TestTeleporterLandsOnPartnerWithoutBouncing(t *testing.T) {
+	m, _ := newTestManager(t, config.Default())
+	m.startMatch()
+	useGrid(m,
+		"#########",
+		"#.....G.#",
+		"#########",
+	)
+	m.Maze.State.AddTeleporterPair(maze.Position{X: 2, Y: 1}, maze.Position{X: 5, Y: 1})
+	m.Player.Teleport(1, 1, m.Maze.GetTileSize())
+	m.Player.Instant = true
+	m.NPCManager.NPCs = nil
+
+	if !m.movePlayer(1, 0) {
+		t.Fatal("the step onto the teleporter was refused")
+	}
+	for frame := 0; frame < 10; frame++ {
+		m.updatePositions()
+	}
+
+	if x, y := m.Player.GetGridPosition(); x != 5 || y != 1 {
+		t.Errorf("player at (%d,%d), want the partner teleporter (5,1)", x, y)
+	}
+	if px, py := m.Player.GetPosition(); px != 5*m.Maze.GetTileSize() || py != m.Maze.GetTileSize() {
+		t.Errorf("player drawn at (%v,%v), not exactly on the partner", px, py)
+	}
+	if m.Player.Moving {
+		t.Error("the player is still sliding after teleporting")
+	}
+}
+
+func TestEmptyTriviaSetSkipsTrivia(t *testing.T) {
+	cfg := config.Default()
+	cfg.TriviaFrequency = 1
+	m, _ := newTestManager(t, cfg)
+	m.startMatch()
+	m.TriviaMgr.Questions = nil
+	m.Player.Instant = true
+	m.TurnManager.NextState(turn.WaitingForMove)
+	dx, dy := openNeighbour(t, m)
+
+	if !m.movePlayer(dx, dy) {
+		t.Fatal("the step was refused")
+	}
+	if m.CurrentState != Playing {
+		t.Errorf("state %v with no questions, want play to carry on", m.CurrentState)
+	}
+	if m.TurnManager.CurrentState == turn.WaitingForTrivia {
+		t.Error("the turn is waiting for a question that doesn't exist")
+	}
+
+	// A trivia phase entered with nothing to ask carries on with the turn
+	m.CurrentState = AnsweringTrivia
+	m.TurnManager.NextState(turn.WaitingForTrivia)
+	m.updateTrivia()
+	if m.CurrentState != Playing || m.TurnManager.CurrentState == turn.WaitingForTrivia {
+		t.Errorf("state %v, turn %v, want play to carry on", m.CurrentState, m.TurnManager.CurrentState)
+	}
+}
+
+func TestTriviaFrequencyPicksTheArrivals(t *testing.T) {
+	tests := []struct {
+		frequency int
+		want      []int
+	}{
+		{frequency: 3, want: []int{3, 6, 9}},
+		{frequency: 0, want: []int{}}, // Action-only play never asks
+	}
+
+	for _, tt := range tests {
+		cfg := config.Default()
+		cfg.TriviaFrequency = tt.frequency
+		m, _ := newTestManager(t, cfg)
+		m.startMatch()
+		useGrid(m,
+			"#######",
+			"#....G#",
+			"#######",
+		)
+		m.Player.Teleport(1, 1, m.Maze.GetTileSize())
+		m.Player.Instant = true
+		m.NPCManager.NPCs = nil
+
+		asked := []int{}
+		for arrival := 1; arrival <= 9; arrival++ {
+			m.CurrentState = Playing
+			m.TurnManager.NextState(turn.WaitingForMove)
+			dx := 1
+			if arrival%2 == 0 {
+				dx = -1
+			}
+			if !m.movePlayer(dx, 0) {
+				t.Fatalf("frequency %d, arrival %d: the step was refused", tt.frequency, arrival)
+			}
+			if m.CurrentState == AnsweringTrivia {
+				asked = append(asked, arrival)
+			}
+		}
+
+		if fmt.Sprint(asked) != fmt.Sprint(tt.want) {
+			t.Errorf("frequency %d: trivia asked on arrivals %v, want %v", tt.frequency, asked, tt.want)
+		}
+	}
+}
+
+func TestNPCDelayWaitsBetweenMoves(t *testing.T) {
+	for _, delay := range []int{0, 3} {
+		cfg := config.Default()
+		cfg.NPCDelayFrames = delay
+		cfg.InstantMovement = true
+		m, _ := newTestManager(t, cfg)
+		m.startMatch()
+		m.NPCManager.RotateChance = 0
+		if len(m.NPCManager.NPCs) < 2 {
+			t.Fatalf("only %d NPCs spawned, want at least 2", len(m.NPCManager.NPCs))
+		}
+		m.TurnManager.EndTurn()
+		m.NPCManager.ResetMovedStatus()
+
+		moved := func() int {
+			count := 0
+			for _, n := range m.NPCManager.NPCs {
+				if n.HasMoved {
+					count++
+				}
+			}
+			return count
+		}
+
+		// The frame each NPC acted on
+		acted := []int{}
+		for frame := 0; frame < 50 && len(acted) < len(m.NPCManager.NPCs); frame++ {
+			before := moved()
+			m.processNPCTurn()
+			if moved() > before {
+				acted = append(acted, frame)
+			}
+		}
+
+		if len(acted) != len(m.NPCManager.NPCs) {
+			t.Fatalf("delay %d: NPCs acted on frames %v, want every NPC to act", delay, acted)
+		}
+		for i := 1; i < len(acted); i++ {
+			if gap := acted[i] - acted[i-1]; gap != delay+1 {
+				t.Errorf("delay %d: NPCs acted on frames %v, want %d frames apart", delay, acted, delay+1)
+				break
+			}
+		}
+	}
+}
+
+func TestRestartMatchResetsTurnAndScore(t *testing.T) {
+	m, _ := newTestManager(t, config.Default())
+	m.startMatch()
+	m.Config.Seed = 0 // Unseeded, so the restart draws a new maze
+	oldMaze, oldGrid := m.Maze, gridTypes(m)
+
+	m.Score.Add(3)
+	m.UIRenderer.Score = m.Score.Points
+	m.TurnManager.EndTurn()
+	m.TurnManager.TurnNumber = 5
+
+	m.restartMatch()
+
+	if m.CurrentState != Playing {
+		t.Errorf("state %v after the restart, want play to go on", m.CurrentState)
+	}
+	if !m.TurnManager.IsPlayerTurn() || m.TurnManager.TurnNumber != 1 || m.TurnManager.CurrentState != turn.WaitingForMove {
+		t.Errorf("turn %d owner %v state %v, want the player's first move", m.TurnManager.TurnNumber, m.TurnManager.CurrentOwner, m.TurnManager.CurrentState)
+	}
+	if m.Score.Points != 0 || m.UIRenderer.Score != 0 {
+		t.Errorf("score %d (shown %d) after the restart, want 0", m.Score.Points, m.UIRenderer.Score)
+	}
+	if m.Maze == oldMaze || sameGrid(gridTypes(m), oldGrid) {
+		t.Error("the restart kept the old maze")
+	}
+}
+
+// itemText returns the label of the first item with the given action,
+// searching the menu and its submenus
+func itemText(m *menu.Menu, action string) string {
+	if m == nil {
+		return ""
+	}
+	for _, item := range m.Items {
+		if item.Action == action {
+			return item.Text
+		}
+		if text := itemText(item.Submenu, action); text != "" {
+			return text
+		}
+	}
+	return ""
+}
+
+func TestTriviaShuffleIsOffByDefault(t *testing.T) {
+	m, _ := newTestManager(t, config.Default())
+	m.startMatch()
+	if m.Config.TriviaShuffle || m.TriviaMgr.ShuffleOptions {
+		t.Error("trivia options are shuffled by default")
+	}
+	if got := itemText(m.MenuMgr.RootMenu, "toggle_trivia_shuffle"); got != "Shuffle Answers: Off" {
+		t.Errorf("menu shows %q, want Shuffle Answers: Off", got)
+	}
+}
+
+func TestNPCReachingThePlayerEndsTheMatch(t *testing.T) {
+	for _, chase := range []bool{true, false} {
+		cfg := config.Default()
+		cfg.ChaseMode = chase
+		cfg.InstantMovement = true
+		m, _ := newTestManager(t, cfg)
+		m.startMatch()
+		useGrid(m,
+			"#######",
+			"#....G#",
+			"#######",
+		)
+		m.Player.Teleport(3, 1, m.Maze.GetTileSize())
+		m.NPCManager.NPCs = m.NPCManager.NPCs[:1]
+		hunter := m.NPCManager.NPCs[0]
+		hunter.Teleport(2, 1)
+		hunter.Strategy, hunter.Mix = npc.Chase, nil
+		m.NPCManager.RotateChance = 0
+		m.Config.NPCDelayFrames = 0
+
+		m.TurnManager.EndTurn()
+		m.NPCManager.ResetMovedStatus()
+		for frame := 0; frame < 10 && m.CurrentState == Playing && !m.NPCManager.AllMoved(); frame++ {
+			m.processNPCTurn()
+		}
+
+		if hunter.GridX != 3 {
+			t.Fatalf("chase %v: NPC at (%d,%d), want it on the player's cell", chase, hunter.GridX, hunter.GridY)
+		}
+		caught := m.CurrentState == GameOver && m.Winner == "NPC 1" && m.WinReason == ui.CaughtPlayer
+		if caught != chase {
+			t.Errorf("chase %v: state %v winner %q, want the match lost only while chasing", chase, m.CurrentState, m.Winner)
+		}
+	}
+}
+
+func TestMenuPreviewIsTheMazeThatGetsPlayed(t *testing.T) {
+	m, _ := newTestManager(t, config.Default())
+	preview, previewGrid := m.Maze, gridTypes(m)
+	previewStart := m.Maze.StartPosition()
+
+	m.handleMenuAction("start_game")
+	if m.Maze != preview || !sameGrid(gridTypes(m), previewGrid) {
+		t.Error("starting the game replaced the maze shown in the preview")
+	}
+	if x, y := m.Player.GetGridPosition(); x != previewStart.X || y != previewStart.Y {
+		t.Errorf("player starts on (%d,%d), the preview marks %v", x, y, previewStart)
+	}
+
+	// Any game started with the same seed plays that maze
+	again, _ := newTestManager(t, config.Default())
+	again.handleMenuAction("start_game")
+	if !sameGrid(gridTypes(again), previewGrid) {
+		t.Error("a second game with the same seed played a different maze")
+	}
+}
+
+func TestRerollKeepsTheSeededMaze(t *testing.T) {
+	m, _ := newTestManager(t, config.Default())
+	m.MenuMgr.OpenSubmenu("Customize", 0)
+	previous, before := m.Maze, gridTypes(m)
+
+	m.handleMenuAction("reroll_maze")
+	if m.Maze == previous {
+		t.Fatal("rerolling didn't rebuild the maze, so the preview would stay stale")
+	}
+	if !sameGrid(gridTypes(m), before) {
+		t.Error("rerolling with a seed set changed the maze")
+	}
+}
+
+func TestMissingWallTextureFallsBackToSolid(t *testing.T) {
+	m, _ := newTestManager(t, config.Default())
+	m.MenuMgr.OpenSubmenu("Customize", 0)
+
+	for m.Config.WallStyle != ui.TexturedWalls {
+		m.handleMenuAction("cycle_wall_style")
+	}
+	if m.UIRenderer.MazeOptions.WallTexture != nil || !m.wallTextureFailed {
+		t.Fatal("a texture was loaded although the file doesn't exist")
+	}
+	if got := m.UIRenderer.MazeOptions.WallStyle.Resolve(m.UIRenderer.MazeOptions.WallTexture); got != ui.SolidWalls {
+		t.Errorf("walls drawn %v without a texture, want solid", got)
+	}
+}
+
+func TestInstantMovesSettleOnTheSameUpdate(t *testing.T) {
+	cfg := config.Default()
+	cfg.InstantMovement = true
+	cfg.TriviaFrequency = 0
+	m, _ := newTestManager(t, cfg)
+	m.startMatch()
+	m.TurnManager.NextState(turn.WaitingForMove)
+	dx, dy := openNeighbour(t, m)
+	x, y := m.Player.GetGridPosition()
+
+	if !m.movePlayer(dx, dy) {
+		t.Fatal("the step was refused")
+	}
+	if m.Player.Moving || m.TurnManager.CurrentState == turn.WaitingForMove {
+		t.Errorf("moving %v, turn %v straight after the step, want the arrival handled", m.Player.Moving, m.TurnManager.CurrentState)
+	}
+	if route := m.Route(); route[len(route)-1] != (maze.Position{X: x + dx, Y: y + dy}) {
+		t.Errorf("route ends on %v, want the new cell", route[len(route)-1])
+	}
+}
+
+func TestNPCRandomnessOnlyOfferedToChasers(t *testing.T) {
+	m, _ := newTestManager(t, config.Default())
+	if !findHidden(m.MenuMgr.RootMenu, "cycle_npc_wander") {
+		t.Error("NPC Randomness offered while the NPCs only wander")
+	}
+
+	m.handleMenuAction("toggle_chase")
+	if findHidden(m.MenuMgr.RootMenu, "cycle_npc_wander") {
+		t.Error("NPC Randomness hidden with chase mode on")
+	}
+}
+
+// findHidden reports whether the item with the given action is hidden
+func findHidden(m *menu.Menu, action string) bool {
+	if m == nil {
+		return false
+	}
+	for _, item := range m.Items {
+		if item.Action == action {
+			return item.Hidden
+		}
+		if findHidden(item.Submenu, action) {
+			return true
+		}
+	}
+	return false
+}
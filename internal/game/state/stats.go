@@ -0,0 +1,59 @@
+// internal/game/state/stats.go
+package state
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/JacobCromwell/Mazenasium/internal/game/action"
+)
+
+// MatchStats accumulates what happened during a match for the results screen
+type MatchStats struct {
+	PlayerMoves   int                       // Moves the player chose, not counting trap returns
+	ActionsUsed   map[action.ActionType]int // How many times each action was used
+	TriviaCorrect int
+	TriviaTotal   int
+	Elapsed       time.Duration // Play time, frozen once the match ends
+}
+
+// NewMatchStats creates an empty set of match statistics
+func NewMatchStats() *MatchStats {
+	return &MatchStats{
+		ActionsUsed: make(map[action.ActionType]int),
+	}
+}
+
+// RecordMove counts a move chosen by the player
+func (s *MatchStats) RecordMove() {
+	s.PlayerMoves++
+}
+
+// RecordAction counts a use of the given action
+func (s *MatchStats) RecordAction(actionType action.ActionType) {
+	s.ActionsUsed[actionType]++
+}
+
+// UnrecordAction takes back a use of the given action that was undone
+func (s *MatchStats) UnrecordAction(actionType action.ActionType) {
+	if s.ActionsUsed[actionType] > 0 {
+		s.ActionsUsed[actionType]--
+	}
+}
+
+// RecordTrivia counts an answered trivia question
+func (s *MatchStats) RecordTrivia(correct bool) {
+	s.TriviaTotal++
+	if correct {
+		s.TriviaCorrect++
+	}
+}
+
+// ActionSummary formats the per-action counts in the action manager's order
+func (s *MatchStats) ActionSummary(actions []action.Action) []string {
+	lines := []string{}
+	for _, a := range actions {
+		lines = append(lines, fmt.Sprintf("%s: %d", a.Name, s.ActionsUsed[a.Type]))
+	}
+	return lines
+}
@@ -0,0 +1,81 @@
+// internal/game/state/stats_test.go
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/JacobCromwell/Mazenasium/internal/game/action"
+	"github.com/JacobCromwell/Mazenasium/internal/game/config"
+	"github.com/JacobCromwell/Mazenasium/internal/game/ui"
+)
+
+func TestMatchStatsAccumulateOverScriptedMatch(t *testing.T) {
+	cfg := config.Default()
+	cfg.InstantMovement = true
+	m, clk := newTestManager(t, cfg)
+	m.startMatch()
+
+	for step := 0; step < 3; step++ {
+		dx, dy := openNeighbour(t, m)
+		if !m.movePlayer(dx, dy) {
+			t.Fatalf("step %d was refused", step)
+		}
+	}
+	m.useAction(action.XRotateLeft)
+	m.useAction(action.Swap)
+	m.useAction(action.Swap)
+	m.Stats.RecordTrivia(true)
+	m.Stats.RecordTrivia(false)
+
+	clk.Advance(90 * time.Second)
+	m.finishGame("NPC 1", ui.ReachedGoal)
+	clk.Advance(time.Minute) // The results screen doesn't keep counting
+
+	if m.Stats.PlayerMoves != 3 {
+		t.Errorf("PlayerMoves = %d, want 3", m.Stats.PlayerMoves)
+	}
+	if got := m.Stats.ActionsUsed[action.Swap]; got != 2 {
+		t.Errorf("Swap used %d times, want 2", got)
+	}
+	if got := m.Stats.ActionsUsed[action.XRotateLeft]; got != 1 {
+		t.Errorf("Rotate Row Left used %d times, want 1", got)
+	}
+	if m.Stats.TriviaCorrect != 1 || m.Stats.TriviaTotal != 2 {
+		t.Errorf("trivia %d/%d, want 1/2", m.Stats.TriviaCorrect, m.Stats.TriviaTotal)
+	}
+	if m.Stats.Elapsed != 90*time.Second {
+		t.Errorf("Elapsed = %v, want it frozen at 1m30s", m.Stats.Elapsed)
+	}
+}
+
+func TestUnrecordActionNeverGoesNegative(t *testing.T) {
+	s := NewMatchStats()
+	s.RecordAction(action.ShuffleRow)
+	s.UnrecordAction(action.ShuffleRow)
+	s.UnrecordAction(action.ShuffleRow)
+
+	if got := s.ActionsUsed[action.ShuffleRow]; got != 0 {
+		t.Errorf("ShuffleRow count = %d after undoing more than was used, want 0", got)
+	}
+}
+
+func TestActionSummaryFollowsActionOrder(t *testing.T) {
+	s := NewMatchStats()
+	s.RecordAction(action.Swap)
+	actions := []action.Action{
+		{Type: action.Swap, Name: "Swap"},
+		{Type: action.PeekGoal, Name: "Peek Goal"},
+	}
+
+	got := s.ActionSummary(actions)
+	want := []string{"Swap: 1", "Peek Goal: 0"}
+	if len(got) != len(want) {
+		t.Fatalf("ActionSummary() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("line %d = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
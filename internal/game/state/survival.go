@@ -0,0 +1,65 @@
+// internal/game/state/survival.go
+package state
+
+import (
+	"fmt"
+
+	"github.com/JacobCromwell/Mazenasium/internal/game/config"
+	"github.com/JacobCromwell/Mazenasium/internal/game/ui"
+)
+
+// survivalTurnOptions are the survival lengths offered in the customize menu
+var survivalTurnOptions = []int{10, 20, 30, 50}
+
+// nextSurvivalTurns returns the survival length after current in the menu cycle
+func nextSurvivalTurns(current int) int {
+	for _, turns := range survivalTurnOptions {
+		if turns > current {
+			return turns
+		}
+	}
+	return survivalTurnOptions[0]
+}
+
+// survival checks if this match is won by lasting rather than by racing.
+// The sandbox has no player, so it always races
+func (m *Manager) survival() bool {
+	return m.Config.WinMode == config.Survival && !m.Sandbox
+}
+
+// goalWins checks if reaching the goal ends the match. In survival and
+// trivia score modes the goal is only scenery
+func (m *Manager) goalWins() bool {
+	return !m.survival() && !m.scoreRace()
+}
+
+// chasing checks if NPCs are out to catch the player, which survival
+// mode always needs
+func (m *Manager) chasing() bool {
+	return (m.Config.ChaseMode || m.survival()) && !m.Sandbox
+}
+
+// SurvivalTurnsLeft returns how many more rounds the player has to last,
+// 0 outside survival mode
+func (m *Manager) SurvivalTurnsLeft() int {
+	if !m.survival() {
+		return 0
+	}
+	completed := m.TurnManager.TurnNumber - 1
+	return max(0, m.Config.SurvivalTurns-completed)
+}
+
+// checkSurvival declares the player the winner once they have lasted the
+// configured number of rounds without being caught
+func (m *Manager) checkSurvival() {
+	if m.CurrentState != Playing || !m.survival() || m.Demo.Active {
+		return
+	}
+
+	left := m.SurvivalTurnsLeft()
+	m.UIRenderer.SurvivalTurnsLeft = left
+	if left == 0 {
+		m.Log(fmt.Sprintf("Player survived %d turns", m.Config.SurvivalTurns))
+		m.finishGame(m.playerWinner(), ui.Survived)
+	}
+}
@@ -0,0 +1,84 @@
+// internal/game/state/survival_test.go
+package state
+
+import (
+	"testing"
+
+	"github.com/JacobCromwell/Mazenasium/internal/game/config"
+	"github.com/JacobCromwell/Mazenasium/internal/game/npc"
+	"github.com/JacobCromwell/Mazenasium/internal/game/ui"
+)
+
+// newSurvivalMatch starts a survival match on a single corridor with one
+// chasing NPC two cells left of the player
+func newSurvivalMatch(t *testing.T) (*Manager, *npc.NPC) {
+	t.Helper()
+	cfg := config.Default()
+	cfg.WinMode = config.Survival
+	cfg.SurvivalTurns = 10
+	cfg.InstantMovement = true
+	m, _ := newTestManager(t, cfg)
+	m.startMatch()
+	useGrid(m,
+		"#########",
+		"#......G#",
+		"#########",
+	)
+	m.Player.Teleport(4, 1, m.Maze.GetTileSize())
+	m.NPCManager.NPCs = m.NPCManager.NPCs[:1]
+	hunter := m.NPCManager.NPCs[0]
+	hunter.Teleport(1, 1)
+	hunter.Strategy, hunter.Mix = npc.Chase, nil
+	m.NPCManager.RotateChance = 0
+	m.Config.NPCDelayFrames = 0
+	return m, hunter
+}
+
+func TestSurvivingTheTurnsWins(t *testing.T) {
+	m, _ := newSurvivalMatch(t)
+
+	m.TurnManager.TurnNumber = m.Config.SurvivalTurns
+	m.checkSurvival()
+	if m.CurrentState != Playing || m.SurvivalTurnsLeft() != 1 {
+		t.Fatalf("state %v with %d turns left, want the match still on", m.CurrentState, m.SurvivalTurnsLeft())
+	}
+
+	m.TurnManager.TurnNumber++
+	m.checkSurvival()
+	if m.CurrentState != GameOver || m.Winner != "Player" || m.WinReason != ui.Survived {
+		t.Errorf("state %v winner %q reason %v after %d turns, want the player to survive", m.CurrentState, m.Winner, m.WinReason, m.Config.SurvivalTurns)
+	}
+}
+
+func TestCaughtBeforeTheThresholdLoses(t *testing.T) {
+	m, hunter := newSurvivalMatch(t)
+
+	for round := 0; round < 3 && m.CurrentState == Playing; round++ {
+		m.TurnManager.EndTurn()
+		m.NPCManager.ResetMovedStatus()
+		for frame := 0; frame < 10 && m.CurrentState == Playing && !m.NPCManager.AllMoved(); frame++ {
+			m.processNPCTurn()
+		}
+		if m.CurrentState == Playing && !m.TurnManager.IsPlayerTurn() {
+			m.processNPCTurn()
+		}
+		m.checkSurvival()
+	}
+
+	if m.CurrentState != GameOver || m.Winner != "NPC 1" || m.WinReason != ui.CaughtPlayer {
+		t.Errorf("state %v winner %q reason %v with the NPC on (%d,%d), want the chaser to catch the player", m.CurrentState, m.Winner, m.WinReason, hunter.GridX, hunter.GridY)
+	}
+}
+
+func TestGoalIsInertInSurvival(t *testing.T) {
+	m, _ := newSurvivalMatch(t)
+	m.Player.Teleport(6, 1, m.Maze.GetTileSize())
+	m.Player.Instant = true
+
+	if !m.movePlayer(1, 0) {
+		t.Fatal("the step onto the goal was refused")
+	}
+	if m.CurrentState != Playing {
+		t.Errorf("state %v after reaching the goal, want survival to carry on", m.CurrentState)
+	}
+}
@@ -0,0 +1,41 @@
+// internal/game/state/undo.go
+package state
+
+import (
+	"github.com/JacobCromwell/Mazenasium/internal/game/action"
+	"github.com/JacobCromwell/Mazenasium/internal/game/maze"
+	"github.com/JacobCromwell/Mazenasium/internal/game/turn"
+)
+
+// rotationUndo remembers the row an X-rotation changed, so the player can
+// take the rotation back before ending their turn
+type rotationUndo struct {
+	Y      int
+	Tiles  []maze.Tile
+	Action action.ActionType
+}
+
+// CanUndoRotation reports whether this turn's rotation can still be undone
+func (m *Manager) CanUndoRotation() bool {
+	return m.lastRotation != nil
+}
+
+// undoRotation restores the row from before this turn's rotation, refunds
+// the action and lets the player choose again
+func (m *Manager) undoRotation() {
+	undo := m.lastRotation
+	if undo == nil {
+		return
+	}
+	m.lastRotation = nil
+
+	// The snapshot was taken while the rotation preview was highlighted
+	m.Maze.State.RestoreRow(undo.Y, undo.Tiles)
+	m.Maze.ClearHighlights()
+	m.ActionMgr.RefundAction(undo.Action)
+	m.Stats.UnrecordAction(undo.Action)
+
+	m.UIRenderer.SetActionMessage("X-Rotate undone", 60)
+	m.Log("X-Rotate undone")
+	m.TurnManager.NextState(turn.WaitingForAction)
+}
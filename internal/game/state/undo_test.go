@@ -0,0 +1,104 @@
+// internal/game/state/undo_test.go
+package state
+
+import (
+	"testing"
+
+	"github.com/JacobCromwell/Mazenasium/internal/game/action"
+	"github.com/JacobCromwell/Mazenasium/internal/game/config"
+	"github.com/JacobCromwell/Mazenasium/internal/game/maze"
+	"github.com/JacobCromwell/Mazenasium/internal/game/turn"
+)
+
+// gridTiles copies every tile of the maze
+func gridTiles(m *Manager) [][]maze.Tile {
+	tiles := make([][]maze.Tile, m.Maze.State.Height)
+	for y, row := range m.Maze.State.Grid {
+		tiles[y] = make([]maze.Tile, len(row))
+		for x, tile := range row {
+			tiles[y][x] = *tile
+		}
+	}
+	return tiles
+}
+
+// typesOf returns the tile types of copied tiles
+func typesOf(tiles [][]maze.Tile) [][]maze.TileType {
+	types := make([][]maze.TileType, len(tiles))
+	for y, row := range tiles {
+		types[y] = make([]maze.TileType, len(row))
+		for x, tile := range row {
+			types[y][x] = tile.Type
+		}
+	}
+	return types
+}
+
+func TestUndoRotationRestoresGridAndRefunds(t *testing.T) {
+	m, _ := newTestManager(t, config.Default())
+	m.startMatch()
+	useGrid(m,
+		"#########",
+		"#..#.#..#",
+		"#......G#",
+		"#########",
+	)
+	state := m.Maze.State
+	state.AddTeleporterPair(maze.Position{X: 6, Y: 1}, maze.Position{X: 2, Y: 2})
+	state.RecordVisit(4, 1)
+	state.RecordVisit(4, 1)
+	m.Player.Teleport(1, 1, m.Maze.GetTileSize())
+	m.NPCManager.NPCs = nil
+	m.TurnManager.NextState(turn.WaitingForAction)
+	before := gridTiles(m)
+	beforeRotations := m.Stats.ActionsUsed[action.XRotateRight]
+
+	m.handleActionSelection(action.Action{Type: action.XRotateRight})
+	m.confirmXRotate()
+	if !m.CanUndoRotation() || m.ActionMgr.IsActionAvailable(action.XRotateRight) {
+		t.Fatal("the rotation wasn't applied and spent")
+	}
+	if sameGrid(gridTypes(m), typesOf(before)) {
+		t.Fatal("the rotation didn't change the row")
+	}
+
+	m.undoRotation()
+	after := gridTiles(m)
+	for y := range before {
+		for x := range before[y] {
+			if after[y][x] != before[y][x] {
+				t.Errorf("tile (%d,%d) = %+v after undo, want %+v", x, y, after[y][x], before[y][x])
+			}
+		}
+	}
+	if target, ok := state.TeleportTarget(6, 1); !ok || target != (maze.Position{X: 2, Y: 2}) {
+		t.Errorf("teleporter on (6,1) leads to %v, %v after undo, want (2,2)", target, ok)
+	}
+	if !m.ActionMgr.IsActionAvailable(action.XRotateRight) {
+		t.Error("the undone rotation wasn't refunded")
+	}
+	if m.Stats.ActionsUsed[action.XRotateRight] != beforeRotations {
+		t.Errorf("%d rotations in the stats after undo, want %d", m.Stats.ActionsUsed[action.XRotateRight], beforeRotations)
+	}
+	if m.CanUndoRotation() || m.TurnManager.CurrentState != turn.WaitingForAction {
+		t.Errorf("undo still offered %v, turn %v, want a fresh action choice", m.CanUndoRotation(), m.TurnManager.CurrentState)
+	}
+}
+
+func TestEndingTheTurnDropsTheUndo(t *testing.T) {
+	m, _ := newTestManager(t, config.Default())
+	m.startMatch()
+	m.Player.Teleport(1, 1, m.Maze.GetTileSize())
+	openPlayerRow(m, 3)
+	m.NPCManager.NPCs = nil
+
+	m.handleActionSelection(action.Action{Type: action.XRotateRight})
+	m.confirmXRotate()
+	if !m.CanUndoRotation() {
+		t.Fatal("the rotation can't be undone")
+	}
+	m.endPlayerTurn()
+	if m.CanUndoRotation() {
+		t.Error("the rotation can still be undone in a later turn")
+	}
+}
@@ -0,0 +1,34 @@
+// internal/game/trivia/penalty.go
+package trivia
+
+// WrongAnswerPenalty is what a wrong trivia answer costs the player
+type WrongAnswerPenalty int
+
+const (
+	NoPenalty     WrongAnswerPenalty = iota // The turn carries on as if answered correctly
+	ForfeitAction                           // The action phase is skipped this turn
+	PushBack                                // The player is pushed back to the tile they came from
+	SkipTurn                                // The rest of the turn is lost
+)
+
+// String returns the name shown in the menu
+func (p WrongAnswerPenalty) String() string {
+	switch p {
+	case ForfeitAction:
+		return "Lose Action"
+	case PushBack:
+		return "Push Back"
+	case SkipTurn:
+		return "Skip Turn"
+	default:
+		return "None"
+	}
+}
+
+// Next returns the penalty that follows this one in the menu cycle
+func (p WrongAnswerPenalty) Next() WrongAnswerPenalty {
+	if p >= SkipTurn {
+		return NoPenalty
+	}
+	return p + 1
+}
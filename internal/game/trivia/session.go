@@ -0,0 +1,61 @@
+// internal/game/trivia/session.go
+package trivia
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Result is one answered question from the current session
+type Result struct {
+	Question string `json:"question"`
+	Choice   string `json:"choice"` // Option the player picked, empty if out of range
+	Answer   string `json:"answer"` // The right option
+	Correct  bool   `json:"correct"`
+}
+
+// recordResult adds the answer just checked to the session
+func (m *Manager) recordResult(answerIndex int) {
+	question := m.Questions[m.CurrentIndex]
+	result := Result{
+		Question: question.Question,
+		Correct:  m.Correct,
+	}
+	if original := m.originalIndex(answerIndex); original >= 0 && original < len(question.Options) {
+		result.Choice = question.Options[original]
+	}
+	if question.Answer >= 0 && question.Answer < len(question.Options) {
+		result.Answer = question.Options[question.Answer]
+	}
+	m.session = append(m.session, result)
+}
+
+// SessionSummary returns every question answered this session, oldest first
+func (m *Manager) SessionSummary() []Result {
+	return append([]Result(nil), m.session...)
+}
+
+// RestoreSession replaces the session's answers, such as ones from a saved game
+func (m *Manager) RestoreSession(results []Result) {
+	m.session = append([]Result(nil), results...)
+}
+
+// ResetSession forgets the answers given so far
+func (m *Manager) ResetSession() {
+	m.session = nil
+}
+
+// WriteSessionSummary saves the session's answers to a JSON file for review
+func (m *Manager) WriteSessionSummary(path string) error {
+	data, err := json.MarshalIndent(m.SessionSummary(), "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode trivia summary: %v", err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write trivia summary: %v", err)
+	}
+
+	return nil
+}
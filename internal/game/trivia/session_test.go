@@ -0,0 +1,80 @@
+// internal/game/trivia/session_test.go
+package trivia
+
+import (
+	"encoding/json"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// answer asks q on m and answers it with the option at index
+func answer(m *Manager, q Question, index int) {
+	m.Questions = []Question{q}
+	m.SetRandomQuestion(rand.New(rand.NewSource(1)).Intn)
+	m.CheckAnswer(index)
+}
+
+func TestSessionSummaryKeepsAnswerOrder(t *testing.T) {
+	m := &Manager{}
+	answer(m, Question{Question: "2 + 2?", Options: []string{"3", "4"}, Answer: 1}, 1)
+	answer(m, NewTrueFalse("The sun is a planet.", false), 0)
+	answer(m, Question{Question: "Capital of France?", Options: []string{"Paris", "Rome", "Oslo"}, Answer: 0}, 0)
+
+	want := []Result{
+		{Question: "2 + 2?", Choice: "4", Answer: "4", Correct: true},
+		{Question: "The sun is a planet.", Choice: "True", Answer: "False", Correct: false},
+		{Question: "Capital of France?", Choice: "Paris", Answer: "Paris", Correct: true},
+	}
+	got := m.SessionSummary()
+	if len(got) != len(want) {
+		t.Fatalf("%d results in the summary, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("result %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+
+	// The summary is a copy the caller can't use to rewrite the session
+	got[0].Correct = false
+	if !m.SessionSummary()[0].Correct {
+		t.Error("changing the returned summary changed the session")
+	}
+}
+
+func TestResetSessionStartsOver(t *testing.T) {
+	m := &Manager{}
+	answer(m, NewTrueFalse("Water is wet.", true), 0)
+	m.ResetSession()
+	if len(m.SessionSummary()) != 0 {
+		t.Fatalf("%d results after a reset, want none", len(m.SessionSummary()))
+	}
+
+	answer(m, NewTrueFalse("Ice is hot.", false), 1)
+	if got := m.SessionSummary(); len(got) != 1 || got[0].Question != "Ice is hot." {
+		t.Errorf("summary after a reset = %+v, want only the new answer", got)
+	}
+}
+
+func TestWriteSessionSummary(t *testing.T) {
+	m := &Manager{}
+	answer(m, NewTrueFalse("Water is wet.", true), 0)
+	path := filepath.Join(t.TempDir(), "summary.json")
+
+	if err := m.WriteSessionSummary(path); err != nil {
+		t.Fatalf("WriteSessionSummary() = %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var written []Result
+	if err := json.Unmarshal(data, &written); err != nil {
+		t.Fatalf("summary isn't valid JSON: %v", err)
+	}
+	if len(written) != 1 || written[0] != m.SessionSummary()[0] {
+		t.Errorf("wrote %+v, want %+v", written, m.SessionSummary())
+	}
+}
@@ -11,15 +11,49 @@ type Manager struct {
 	CurrentIndex int
 	Answered     bool
 	Correct      bool
+
+	// Present each question's options in a random order
+	ShuffleOptions bool
+	// Original option index for each displayed position of the current question
+	order []int
+	// Questions answered this session, in order
+	session []Result
+	// How each question has gone so far, keyed by question text
+	stats map[string]QuestionStats
 }
 
+// MaxOptions is the most answers a question can offer, one per number key
+const MaxOptions = 9
+
 // Question represents a single trivia question
+// with between 2 (true/false) and MaxOptions options
 type Question struct {
 	Question string
 	Options  []string
 	Answer   int
 }
 
+// NewTrueFalse creates a two-option question answered with True or False
+func NewTrueFalse(question string, answer bool) Question {
+	answerIndex := 1
+	if answer {
+		answerIndex = 0
+	}
+	return Question{
+		Question: question,
+		Options:  []string{"True", "False"},
+		Answer:   answerIndex,
+	}
+}
+
+// OptionCount returns how many options can be chosen, capped at MaxOptions
+func (q Question) OptionCount() int {
+	if len(q.Options) > MaxOptions {
+		return MaxOptions
+	}
+	return len(q.Options)
+}
+
 // NewManager creates a new trivia manager with default questions
 func NewManager() *Manager {
 	return &Manager{
@@ -58,34 +92,107 @@ func LoadDefaultQuestions() []Question {
 			Options:  []string{"Charles Dickens", "William Shakespeare", "Jane Austen", "Mark Twain"},
 			Answer:   1, // Shakespeare
 		},
+		NewTrueFalse("Sound travels faster in water than in air.", true),
+		{
+			Question: "How many sides does a hexagon have?",
+			Options:  []string{"4", "5", "6", "7", "8", "10"},
+			Answer:   2, // 6
+		},
+	}
+}
+
+// HasQuestions checks if there is at least one question to ask
+func (m *Manager) HasQuestions() bool {
+	return len(m.Questions) > 0
+}
+
+// GetCurrentQuestion returns the current question as it is displayed,
+// with its options in shuffled order and Answer pointing at the right one
+// Returns false if the question set is empty
+func (m *Manager) GetCurrentQuestion() (Question, bool) {
+	if m.CurrentIndex < 0 || m.CurrentIndex >= len(m.Questions) {
+		return Question{}, false
 	}
+	question := m.Questions[m.CurrentIndex]
+	if len(m.order) != len(question.Options) {
+		return question, true
+	}
+	
+	displayed := Question{Question: question.Question, Options: make([]string, len(m.order))}
+	for i, original := range m.order {
+		displayed.Options[i] = question.Options[original]
+		if original == question.Answer {
+			displayed.Answer = i
+		}
+	}
+	return displayed, true
+}
+
+// originalIndex translates a displayed option position back to its index in
+// the question's own option list
+func (m *Manager) originalIndex(displayIndex int) int {
+	if displayIndex < 0 || displayIndex >= len(m.order) {
+		return displayIndex
+	}
+	return m.order[displayIndex]
 }
 
-// GetCurrentQuestion returns the current question
-func (m *Manager) GetCurrentQuestion() Question {
-	return m.Questions[m.CurrentIndex]
+// shuffleOrder picks the order the current question's options are shown in.
+// Options stay in place unless ShuffleOptions is set
+func (m *Manager) shuffleOrder(randomFunc func(int) int) {
+	m.order = nil
+	if !m.ShuffleOptions {
+		return
+	}
+	
+	// Fisher-Yates shuffle
+	m.order = make([]int, len(m.Questions[m.CurrentIndex].Options))
+	for i := range m.order {
+		m.order[i] = i
+	}
+	for i := len(m.order) - 1; i > 0; i-- {
+		j := randomFunc(i + 1)
+		m.order[i], m.order[j] = m.order[j], m.order[i]
+	}
 }
 
-// SetRandomQuestion selects a random question from the available questions
-func (m *Manager) SetRandomQuestion(randomFunc func(int) int) {
-	m.CurrentIndex = randomFunc(len(m.Questions))
+// SetRandomQuestion selects a random question from the available questions,
+// more often ones the player has got wrong before
+// Returns false if the question set is empty
+func (m *Manager) SetRandomQuestion(randomFunc func(int) int) bool {
 	m.Answered = false
+	if !m.HasQuestions() {
+		m.CurrentIndex = 0
+		return false
+	}
+	m.CurrentIndex = m.weightedIndex(randomFunc)
+	m.shuffleOrder(randomFunc)
+	return true
 }
 
-// CheckAnswer checks if the provided answer index is correct
+// CheckAnswer checks if the option displayed at answerIndex is correct
 func (m *Manager) CheckAnswer(answerIndex int) bool {
 	m.Answered = true
-	m.Correct = (answerIndex == m.Questions[m.CurrentIndex].Answer)
+	if m.CurrentIndex < 0 || m.CurrentIndex >= len(m.Questions) {
+		m.Correct = false
+		return false
+	}
+	m.Correct = m.originalIndex(answerIndex) == m.Questions[m.CurrentIndex].Answer
+	m.recordResult(answerIndex)
+	m.recordStats()
 	return m.Correct
 }
 
 // HandleInput processes keyboard input for trivia answering
 // Returns true if an answer was selected
 func (m *Manager) HandleInput() bool {
-	question := m.Questions[m.CurrentIndex]
+	question, ok := m.GetCurrentQuestion()
+	if !ok {
+		return false
+	}
 	
 	// Check for answer selection
-	for i := 0; i < len(question.Options); i++ {
+	for i := 0; i < question.OptionCount(); i++ {
 		if inpututil.IsKeyJustPressed(ebiten.Key1 + ebiten.Key(i)) {
 			m.CheckAnswer(i)
 			return true
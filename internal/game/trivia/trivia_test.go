@@ -0,0 +1,95 @@
+// internal/game/trivia/trivia_test.go
+package trivia
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestTwoAndSixOptionQuestions(t *testing.T) {
+	questions := []Question{
+		NewTrueFalse("Sound travels faster in water than in air.", true),
+		{
+			Question: "How many sides does a hexagon have?",
+			Options:  []string{"4", "5", "6", "7", "8", "10"},
+			Answer:   2,
+		},
+	}
+
+	for _, shuffle := range []bool{false, true} {
+		for _, q := range questions {
+			m := &Manager{Questions: []Question{q}, ShuffleOptions: shuffle}
+			m.SetRandomQuestion(rand.New(rand.NewSource(3)).Intn)
+
+			shown, ok := m.GetCurrentQuestion()
+			if !ok || shown.OptionCount() != len(q.Options) {
+				t.Fatalf("%d-option question shows %d options", len(q.Options), shown.OptionCount())
+			}
+			if shown.Options[shown.Answer] != q.Options[q.Answer] {
+				t.Fatalf("shuffle %v: displayed answer %q, want %q", shuffle, shown.Options[shown.Answer], q.Options[q.Answer])
+			}
+
+			for i := range shown.Options {
+				if got := m.CheckAnswer(i); got != (i == shown.Answer) {
+					t.Errorf("shuffle %v, %d options: CheckAnswer(%d) = %v", shuffle, len(q.Options), i, got)
+				}
+			}
+		}
+	}
+}
+
+func TestEmptyQuestionSet(t *testing.T) {
+	m := &Manager{}
+
+	if m.SetRandomQuestion(func(int) int { t.Fatal("picked from an empty set"); return 0 }) {
+		t.Error("SetRandomQuestion reported a question from an empty set")
+	}
+	if _, ok := m.GetCurrentQuestion(); ok {
+		t.Error("GetCurrentQuestion returned a question from an empty set")
+	}
+	if m.CheckAnswer(0) {
+		t.Error("an answer counted as correct with no question")
+	}
+}
+
+func TestShuffledCorrectOptionScoresCorrect(t *testing.T) {
+	q := Question{
+		Question: "Which planet is closest to the sun?",
+		Options:  []string{"Venus", "Mercury", "Mars", "Earth"},
+		Answer:   1,
+	}
+
+	moved := false
+	for seed := int64(1); seed <= 20; seed++ {
+		m := &Manager{Questions: []Question{q}, ShuffleOptions: true}
+		m.SetRandomQuestion(rand.New(rand.NewSource(seed)).Intn)
+
+		shown, _ := m.GetCurrentQuestion()
+		if shown.Options[shown.Answer] != "Mercury" {
+			t.Fatalf("seed %d: displayed answer %q, want Mercury", seed, shown.Options[shown.Answer])
+		}
+		if shown.Answer != q.Answer {
+			moved = true
+		}
+		if !m.CheckAnswer(shown.Answer) {
+			t.Errorf("seed %d: picking Mercury at position %d was scored wrong", seed, shown.Answer+1)
+		}
+	}
+
+	if !moved {
+		t.Error("the correct option never moved, the shuffle wasn't exercised")
+	}
+}
+
+func TestOptionsKeepTheirOrderUnlessShuffled(t *testing.T) {
+	q := Question{Question: "2 + 2?", Options: []string{"3", "4", "5"}, Answer: 1}
+	m := &Manager{Questions: []Question{q}}
+	m.SetRandomQuestion(rand.New(rand.NewSource(1)).Intn)
+
+	shown, _ := m.GetCurrentQuestion()
+	for i := range q.Options {
+		if shown.Options[i] != q.Options[i] {
+			t.Fatalf("options shown as %v, want %v", shown.Options, q.Options)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+// internal/game/trivia/weighting.go
+package trivia
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+)
+
+// weightScale turns question weights into whole numbers for randomFunc,
+// keeping three decimal places
+const weightScale = 1000
+
+// QuestionStats is how a question has gone for the player so far
+type QuestionStats struct {
+	Asked   int `json:"asked"`
+	Correct int `json:"correct"`
+}
+
+// Weight returns how likely the question is to be picked, relative to the
+// others. It is the inverse of the correct rate, smoothed so an unseen
+// question sits between one always missed and one always answered: a new
+// question weighs 2, one always answered right tends toward 1 and one always
+// missed grows by one for every miss
+func (s QuestionStats) Weight() float64 {
+	return float64(s.Asked+2) / float64(s.Correct+1)
+}
+
+// recordStats counts an answer to the current question toward its stats
+func (m *Manager) recordStats() {
+	if m.stats == nil {
+		m.stats = make(map[string]QuestionStats)
+	}
+	key := m.Questions[m.CurrentIndex].Question
+	stats := m.stats[key]
+	stats.Asked++
+	if m.Correct {
+		stats.Correct++
+	}
+	m.stats[key] = stats
+}
+
+// Stats returns a copy of the stats for every question answered so far,
+// keyed by question text
+func (m *Manager) Stats() map[string]QuestionStats {
+	stats := make(map[string]QuestionStats, len(m.stats))
+	for question, s := range m.stats {
+		stats[question] = s
+	}
+	return stats
+}
+
+// SetStats replaces the question stats, such as ones carried over from a
+// previous match
+func (m *Manager) SetStats(stats map[string]QuestionStats) {
+	m.stats = make(map[string]QuestionStats, len(stats))
+	for question, s := range stats {
+		m.stats[question] = s
+	}
+}
+
+// weightedIndex picks a question index, favouring the questions the player
+// gets wrong most often
+func (m *Manager) weightedIndex(randomFunc func(int) int) int {
+	weights := make([]int, len(m.Questions))
+	total := 0
+	for i, question := range m.Questions {
+		weights[i] = int(math.Round(m.stats[question.Question].Weight() * weightScale))
+		total += weights[i]
+	}
+
+	pick := randomFunc(total)
+	for i, weight := range weights {
+		if pick < weight {
+			return i
+		}
+		pick -= weight
+	}
+	return len(m.Questions) - 1
+}
+
+// LoadStats reads question stats saved by SaveStats.
+// A missing file leaves the stats empty without an error
+func (m *Manager) LoadStats(path string) error {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read trivia stats: %v", err)
+	}
+
+	var stats map[string]QuestionStats
+	if err := json.Unmarshal(data, &stats); err != nil {
+		return fmt.Errorf("failed to parse trivia stats: %v", err)
+	}
+	m.SetStats(stats)
+
+	return nil
+}
+
+// SaveStats writes the question stats to a JSON file
+func (m *Manager) SaveStats(path string) error {
+	data, err := json.MarshalIndent(m.Stats(), "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode trivia stats: %v", err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write trivia stats: %v", err)
+	}
+
+	return nil
+}
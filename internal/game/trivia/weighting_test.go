@@ -0,0 +1,70 @@
+// internal/game/trivia/weighting_test.go
+package trivia
+
+import (
+	"math/rand"
+	"path/filepath"
+	"testing"
+)
+
+func TestMissedQuestionsComeBackMoreOften(t *testing.T) {
+	missed := NewTrueFalse("The moon is a planet.", false)
+	known := NewTrueFalse("Water boils at 100C at sea level.", true)
+	m := &Manager{}
+
+	// Miss the first question and get the second right, three times each
+	for i := 0; i < 3; i++ {
+		answer(m, missed, 0)
+		answer(m, known, 0)
+	}
+	stats := m.Stats()
+	if stats[missed.Question] != (QuestionStats{Asked: 3}) || stats[known.Question] != (QuestionStats{Asked: 3, Correct: 3}) {
+		t.Fatalf("stats = %+v, want three misses and three right answers", stats)
+	}
+
+	m.Questions = []Question{missed, known}
+	r := rand.New(rand.NewSource(7))
+	picks := map[string]int{}
+	for i := 0; i < 2000; i++ {
+		m.SetRandomQuestion(r.Intn)
+		picks[m.Questions[m.CurrentIndex].Question]++
+	}
+
+	if picks[missed.Question] <= 2*picks[known.Question] {
+		t.Errorf("missed question picked %d times, known one %d, want the missed one far more often", picks[missed.Question], picks[known.Question])
+	}
+}
+
+func TestUnseenQuestionsArePickedEvenly(t *testing.T) {
+	m := &Manager{Questions: []Question{NewTrueFalse("A", true), NewTrueFalse("B", true)}}
+	r := rand.New(rand.NewSource(7))
+	counts := make([]int, 2)
+	for i := 0; i < 2000; i++ {
+		m.SetRandomQuestion(r.Intn)
+		counts[m.CurrentIndex]++
+	}
+	if counts[0] < 900 || counts[1] < 900 {
+		t.Errorf("unseen questions picked %v times, want about even", counts)
+	}
+}
+
+func TestStatsSurviveSaveAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "stats.json")
+	saved := &Manager{}
+	saved.SetStats(map[string]QuestionStats{"Q": {Asked: 4, Correct: 1}})
+	if err := saved.SaveStats(path); err != nil {
+		t.Fatalf("SaveStats() = %v", err)
+	}
+
+	loaded := &Manager{}
+	if err := loaded.LoadStats(path); err != nil {
+		t.Fatalf("LoadStats() = %v", err)
+	}
+	if got := loaded.Stats()["Q"]; got != (QuestionStats{Asked: 4, Correct: 1}) {
+		t.Errorf("loaded stats %+v, want %+v", got, QuestionStats{Asked: 4, Correct: 1})
+	}
+
+	if err := (&Manager{}).LoadStats(filepath.Join(t.TempDir(), "missing.json")); err != nil {
+		t.Errorf("a missing stats file gave %v, want no error", err)
+	}
+}
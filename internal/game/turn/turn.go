@@ -1,6 +1,8 @@
 // internal/game/turn/turn.go
 package turn
 
+import "fmt"
+
 // State represents the current state within a turn
 type State int
 
@@ -25,6 +27,11 @@ const (
 type Manager struct {
 	CurrentState State
 	CurrentOwner Owner
+	TurnNumber   int  // Current round, incremented each time play returns to the player
+	NPCOnly      bool // No human players: every round is an NPC turn
+	OwnerChanged bool // Set when EndTurn hands the turn to the other side, cleared by TakeOwnerChange
+	Humans       int  // Human players who each take a turn before the NPCs, below 2 for one
+	CurrentHuman int  // Index of the human whose turn it is
 }
 
 // NewManager creates a new turn manager
@@ -32,6 +39,7 @@ func NewManager() *Manager {
 	return &Manager{
 		CurrentState: WaitingForMove,
 		CurrentOwner: PlayerTurn,
+		TurnNumber:   1,
 	}
 }
 
@@ -42,13 +50,43 @@ func (m *Manager) NextState(newState State) {
 
 // EndTurn ends the current turn and switches to the next actor
 func (m *Manager) EndTurn() {
-	if m.CurrentOwner == PlayerTurn {
+	if m.NPCOnly {
+		// NPCs hand the turn straight back to each other
+		m.CurrentOwner = NPCTurn
+		m.CurrentState = ProcessingNPCTurn
+		m.TurnNumber++
+		return
+	}
+
+	if m.CurrentOwner == PlayerTurn && m.CurrentHuman+1 < m.Humans {
+		// The next human goes before the NPCs
+		m.CurrentHuman++
+		m.CurrentState = WaitingForMove
+	} else if m.CurrentOwner == PlayerTurn {
 		m.CurrentOwner = NPCTurn
 		m.CurrentState = ProcessingNPCTurn
 	} else {
 		m.CurrentOwner = PlayerTurn
 		m.CurrentState = WaitingForMove
+		m.CurrentHuman = 0
+		m.TurnNumber++
 	}
+	m.OwnerChanged = true
+}
+
+// TakeOwnerChange reports whether the turn changed hands since the last
+// call, clearing the flag so each handoff is seen once
+func (m *Manager) TakeOwnerChange() bool {
+	changed := m.OwnerChanged
+	m.OwnerChanged = false
+	return changed
+}
+
+// StartNPCOnly switches to an all-NPC rotation, starting with the NPCs' turn
+func (m *Manager) StartNPCOnly() {
+	m.NPCOnly = true
+	m.CurrentOwner = NPCTurn
+	m.CurrentState = ProcessingNPCTurn
 }
 
 // IsPlayerTurn checks if it's currently the player's turn
@@ -76,6 +114,9 @@ func (m *Manager) StateText() string {
 
 // OwnerText returns descriptive text for the current turn owner
 func (m *Manager) OwnerText() string {
+	if m.CurrentOwner == PlayerTurn && m.Humans > 1 {
+		return fmt.Sprintf("Player %d's Turn", m.CurrentHuman+1)
+	}
 	if m.CurrentOwner == PlayerTurn {
 		return "Player's Turn"
 	}
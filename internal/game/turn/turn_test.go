@@ -0,0 +1,35 @@
+// internal/game/turn/turn_test.go
+package turn
+
+import "testing"
+
+func TestEndTurnRaisesBannerOncePerHandoff(t *testing.T) {
+	m := NewManager()
+	if m.TakeOwnerChange() {
+		t.Fatal("a new match reported a handoff before any turn ended")
+	}
+
+	for _, want := range []Owner{NPCTurn, PlayerTurn, NPCTurn} {
+		m.EndTurn()
+		if m.CurrentOwner != want {
+			t.Fatalf("owner %v after EndTurn, want %v", m.CurrentOwner, want)
+		}
+		if !m.TakeOwnerChange() {
+			t.Errorf("handing the turn to %v didn't raise the banner", want)
+		}
+		if m.TakeOwnerChange() {
+			t.Errorf("the handoff to %v was reported twice", want)
+		}
+	}
+}
+
+func TestNPCOnlyTurnsRaiseNoBanner(t *testing.T) {
+	m := NewManager()
+	m.StartNPCOnly()
+	for i := 0; i < 3; i++ {
+		m.EndTurn()
+	}
+	if m.TakeOwnerChange() {
+		t.Error("NPCs handing the turn to each other raised the banner")
+	}
+}
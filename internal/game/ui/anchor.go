@@ -0,0 +1,31 @@
+// internal/game/ui/anchor.go
+package ui
+
+// PopupAnchor decides where on screen the action popup is placed
+type PopupAnchor int
+
+const (
+	CenterAnchor PopupAnchor = iota // Middle of the screen
+	BottomAnchor                    // Above the action message at the bottom
+)
+
+// String returns a display name for the anchor option
+func (a PopupAnchor) String() string {
+	if a == BottomAnchor {
+		return "Bottom"
+	}
+	return "Center"
+}
+
+// Next cycles to the following anchor option
+func (a PopupAnchor) Next() PopupAnchor {
+	return (a + 1) % 2
+}
+
+// popupY returns the top of a popup of the given height
+func (a PopupAnchor) popupY(height int) int {
+	if a == BottomAnchor {
+		return ScreenHeight - 80 - height
+	}
+	return (ScreenHeight - height) / 2
+}
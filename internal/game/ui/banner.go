@@ -0,0 +1,67 @@
+// internal/game/ui/banner.go
+package ui
+
+import (
+	"image/color"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+)
+
+// TurnBannerDuration is how long the turn banner stays on screen
+const TurnBannerDuration = time.Second
+
+// Share of the banner's duration spent sliding in, and the point it starts fading
+const (
+	bannerSlideEnd = 0.25
+	bannerFadeFrom = 0.6
+)
+
+// TurnBanner slides a centered strip announcing whose turn it is in from the
+// left, then fades it out. It only draws, so input carries on underneath
+type TurnBanner struct {
+	Text         string
+	Y            int  // Top of the strip
+	HighContrast bool // Draw the text in the high contrast style
+
+	progress float64
+}
+
+// NewTurnBanner creates the banner shown when the turn changes hands
+func (r *Renderer) NewTurnBanner(text string) *TurnBanner {
+	return &TurnBanner{Text: text, Y: ScreenHeight/3 - 30, HighContrast: r.HighContrast}
+}
+
+// Duration returns how long the banner plays
+func (b *TurnBanner) Duration() time.Duration {
+	return TurnBannerDuration
+}
+
+// Update stores the current progress of the banner
+func (b *TurnBanner) Update(progress float64) {
+	b.progress = progress
+}
+
+// Draw renders the strip at its current slide offset and opacity
+func (b *TurnBanner) Draw(screen *ebiten.Image) {
+	// Ease out of the slide so the strip settles in the middle
+	offset := 0.0
+	if b.progress < bannerSlideEnd {
+		remaining := 1 - b.progress/bannerSlideEnd
+		offset = -float64(ScreenWidth) * remaining * remaining
+	}
+
+	alpha := 1.0
+	if b.progress > bannerFadeFrom {
+		alpha = 1 - (b.progress-bannerFadeFrom)/(1-bannerFadeFrom)
+	}
+
+	ebitenutil.DrawRect(screen, offset, float64(b.Y), float64(ScreenWidth), 60, color.RGBA{20, 20, 40, uint8(200 * alpha)})
+
+	textColor := DefaultTextColor
+	textColor.A = uint8(255 * alpha)
+	outlineColor := OutlineColor
+	outlineColor.A = uint8(255 * alpha)
+	DrawTextWithOutline(screen, b.Text, CenteredX(b.Text, ScreenWidth/2)+int(offset), b.Y+16, textColor, outlineColor, b.HighContrast)
+}
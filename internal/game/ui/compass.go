@@ -0,0 +1,125 @@
+// internal/game/ui/compass.go
+package ui
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+
+	"github.com/JacobCromwell/Mazenasium/internal/game/maze"
+	"github.com/JacobCromwell/Mazenasium/internal/game/player"
+)
+
+// CompassMargin keeps the goal compass this many pixels inside the maze section
+const CompassMargin = 24
+
+// compassColor matches the peek goal pointer
+var compassColor = color.RGBA{255, 215, 0, 255}
+
+// CompassDirection returns the unit vector pointing from one screen point to
+// another. ok is false when the points coincide
+func CompassDirection(fromX, fromY, toX, toY float64) (dirX, dirY float64, ok bool) {
+	dx, dy := toX-fromX, toY-fromY
+	length := math.Hypot(dx, dy)
+	if length == 0 {
+		return 0, 0, false
+	}
+	return dx / length, dy / length, true
+}
+
+// CompassPosition returns where along the edge of the area the compass sits
+// when pointing from (fromX, fromY) in the given direction. The point is
+// clamped inside the area so it stays visible even if the origin is not
+func CompassPosition(area Rect, fromX, fromY, dirX, dirY float64) (float64, float64) {
+	left, top := float64(area.X), float64(area.Y)
+	right, bottom := float64(area.X+area.Width), float64(area.Y+area.Height)
+
+	// Walk along the ray to whichever edge it meets first
+	t := math.Inf(1)
+	if dirX > 0 {
+		t = math.Min(t, (right-fromX)/dirX)
+	} else if dirX < 0 {
+		t = math.Min(t, (left-fromX)/dirX)
+	}
+	if dirY > 0 {
+		t = math.Min(t, (bottom-fromY)/dirY)
+	} else if dirY < 0 {
+		t = math.Min(t, (top-fromY)/dirY)
+	}
+	if math.IsInf(t, 1) || t < 0 {
+		t = 0
+	}
+
+	x := math.Max(left, math.Min(right, fromX+dirX*t))
+	y := math.Max(top, math.Min(bottom, fromY+dirY*t))
+	return x, y
+}
+
+// compassArea is the part of the maze section the board is visible in,
+// below the title and inset by CompassMargin
+func compassArea(section Section) Rect {
+	return Rect{
+		X:      section.Rect.X + CompassMargin,
+		Y:      section.Rect.Y + 40 + CompassMargin,
+		Width:  section.Rect.Width - 2*CompassMargin,
+		Height: section.Rect.Height - 40 - 2*CompassMargin,
+	}
+}
+
+// compassNeeded checks if the goal's screen position lies outside the part
+// of the maze section below its title, where the board can be seen
+func compassNeeded(section Section, goalX, goalY float64) bool {
+	visible := Rect{X: section.Rect.X, Y: section.Rect.Y + 40, Width: section.Rect.Width, Height: section.Rect.Height - 40}
+	return !visible.Contains(goalX, goalY)
+}
+
+// drawGoalCompass draws an arrow at the edge of the maze section pointing
+// toward the goal, with its distance in tiles. The maze is drawn from
+// (offsetX, offsetY) at the given scale. Nothing is drawn while the goal is
+// visible inside the section
+func (r *Renderer) drawGoalCompass(screen *ebiten.Image, mazeObj *maze.Maze, playerObj *player.Player, section Section, offsetX, offsetY, scale float64) {
+	// Look the goal up on the live grid, rotations may have moved it
+	goal, ok := mazeObj.State.FindGoal()
+	if !ok {
+		return
+	}
+
+	tileSize := mazeObj.GetTileSize() * scale
+	goalX := offsetX + float64(goal.X)*tileSize + tileSize/2
+	goalY := offsetY + float64(goal.Y)*tileSize + tileSize/2
+	if !compassNeeded(section, goalX, goalY) {
+		return
+	}
+
+	playerX, playerY := playerObj.GetPosition()
+	fromX := offsetX + playerX*scale + tileSize/2
+	fromY := offsetY + playerY*scale + tileSize/2
+	dirX, dirY, ok := CompassDirection(fromX, fromY, goalX, goalY)
+	if !ok {
+		return
+	}
+
+	area := compassArea(section)
+	tipX, tipY := CompassPosition(area, fromX, fromY, dirX, dirY)
+
+	// Arrow shaft ending at the edge, with a head like the goal pointer's
+	shaft := float64(CompassMargin)
+	ebitenutil.DrawLine(screen, tipX-dirX*shaft, tipY-dirY*shaft, tipX, tipY, compassColor)
+	headLength := shaft / 2
+	for _, angle := range []float64{math.Pi * 5 / 6, -math.Pi * 5 / 6} {
+		sin, cos := math.Sincos(angle)
+		headX := tipX + (dirX*cos-dirY*sin)*headLength
+		headY := tipY + (dirX*sin+dirY*cos)*headLength
+		ebitenutil.DrawLine(screen, tipX, tipY, headX, headY, compassColor)
+	}
+
+	gridX, gridY := playerObj.GetGridPosition()
+	distance := maze.Position{X: gridX, Y: gridY}.ManhattanDistance(goal)
+	label := fmt.Sprintf("%d", distance)
+	labelX := int(tipX-dirX*shaft*2) - TextWidth(label)/2
+	labelY := int(tipY - dirY*shaft*2)
+	r.DrawTextColor(screen, label, labelX, labelY, compassColor)
+}
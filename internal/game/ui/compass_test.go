@@ -0,0 +1,58 @@
+// internal/game/ui/compass_test.go
+package ui
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCompassDirectionPointsAtTheGoal(t *testing.T) {
+	tests := []struct {
+		name       string
+		toX, toY   float64
+		wantX, wantY float64
+	}{
+		{"right", 300, 100, 1, 0},
+		{"left", -50, 100, -1, 0},
+		{"below", 100, 400, 0, 1},
+		{"above", 100, 0, 0, -1},
+		{"down-right", 200, 200, math.Sqrt2 / 2, math.Sqrt2 / 2},
+	}
+	for _, tt := range tests {
+		dirX, dirY, ok := CompassDirection(100, 100, tt.toX, tt.toY)
+		if !ok || math.Abs(dirX-tt.wantX) > 1e-9 || math.Abs(dirY-tt.wantY) > 1e-9 {
+			t.Errorf("%s: direction (%v,%v) ok %v, want (%v,%v)", tt.name, dirX, dirY, ok, tt.wantX, tt.wantY)
+		}
+	}
+
+	if _, _, ok := CompassDirection(100, 100, 100, 100); ok {
+		t.Error("a goal under the player has no direction")
+	}
+}
+
+func TestCompassPositionSitsOnTheEdge(t *testing.T) {
+	area := Rect{X: 0, Y: 0, Width: 400, Height: 300}
+	x, y := CompassPosition(area, 100, 150, 1, 0)
+	if x != 400 || y != 150 {
+		t.Errorf("pointing right from (100,150) lands at (%v,%v), want the right edge (400,150)", x, y)
+	}
+}
+
+func TestCompassHiddenWhileGoalIsVisible(t *testing.T) {
+	section := Section{Rect: Rect{X: 600, Y: 0, Width: 600, Height: 1000}}
+	tests := []struct {
+		name         string
+		goalX, goalY float64
+		want         bool
+	}{
+		{"inside the section", 900, 500, false},
+		{"off to the left", 100, 500, true},
+		{"below the section", 900, 1200, true},
+		{"under the title", 900, 20, true},
+	}
+	for _, tt := range tests {
+		if got := compassNeeded(section, tt.goalX, tt.goalY); got != tt.want {
+			t.Errorf("goal %s: compass shown = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+// internal/game/ui/goal_pulse.go
+package ui
+
+import (
+	"math"
+)
+
+// GoalPulsePeriod is how long one glow cycle around the goal lasts, in seconds
+const GoalPulsePeriod = 1.5
+
+// GoalPulse makes the goal tile glow softly in and out to draw the eye
+type GoalPulse struct {
+	Enabled bool    // Accessibility toggle - the goal stays still when false
+	elapsed float64 // Seconds into the current cycle
+}
+
+// NewGoalPulse creates an enabled goal pulse
+func NewGoalPulse() *GoalPulse {
+	return &GoalPulse{Enabled: true}
+}
+
+// Update advances the pulse by dt seconds
+func (p *GoalPulse) Update(dt float64) {
+	p.elapsed = math.Mod(p.elapsed+dt, GoalPulsePeriod)
+}
+
+// Glow returns the current glow strength, easing between 0 and 1.
+// Always 0 while the pulse is disabled
+func (p *GoalPulse) Glow() float64 {
+	if !p.Enabled {
+		return 0
+	}
+	return (1 - math.Cos(2*math.Pi*p.elapsed/GoalPulsePeriod)) / 2
+}
@@ -0,0 +1,46 @@
+// internal/game/ui/goal_pulse_test.go
+package ui
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGoalPulseOscillatesWithinBounds(t *testing.T) {
+	p := NewGoalPulse()
+	low, high := 1.0, 0.0
+	for frame := 0; frame < 600; frame++ {
+		p.Update(1.0 / 60)
+		glow := p.Glow()
+		if glow < 0 || glow > 1 {
+			t.Fatalf("frame %d: glow %v outside [0, 1]", frame, glow)
+		}
+		low, high = math.Min(low, glow), math.Max(high, glow)
+	}
+
+	// Ten seconds cover several cycles, so the glow swings nearly end to end
+	if low > 0.05 || high < 0.95 {
+		t.Errorf("glow only ranged over [%v, %v], want it to rise and fall fully", low, high)
+	}
+}
+
+func TestGoalPulseRepeatsEachPeriod(t *testing.T) {
+	p := NewGoalPulse()
+	p.Update(0.4)
+	first := p.Glow()
+	p.Update(GoalPulsePeriod)
+	if diff := p.Glow() - first; diff > 1e-9 || diff < -1e-9 {
+		t.Errorf("glow %v one period later, want %v again", p.Glow(), first)
+	}
+}
+
+func TestDisabledGoalPulseStaysDark(t *testing.T) {
+	p := NewGoalPulse()
+	p.Enabled = false
+	for frame := 0; frame < 90; frame++ {
+		p.Update(1.0 / 60)
+		if p.Glow() != 0 {
+			t.Fatalf("frame %d: disabled pulse glows %v", frame, p.Glow())
+		}
+	}
+}
@@ -42,6 +42,16 @@ func (i *InputHandler) CheckPlayerMovement() (int, int) {
 	return dx, dy
 }
 
+// NextMove returns the movement direction from the keyboard
+func (i *InputHandler) NextMove() (int, int) {
+	return i.CheckPlayerMovement()
+}
+
+// AnyKeyPressed checks if any key was just pressed
+func (i *InputHandler) AnyKeyPressed() bool {
+	return len(inpututil.AppendJustPressedKeys(nil)) > 0
+}
+
 // CheckMazeRotation checks for maze rotation input
 // Returns: -1 for left rotation, 1 for right rotation, 0 for no rotation
 func (i *InputHandler) CheckMazeRotation() int {
@@ -69,24 +79,27 @@ func (i *InputHandler) CheckEndTurnKey() bool {
 	return inpututil.IsKeyJustPressed(ebiten.KeySpace)
 }
 
-// CheckTriviaInput checks for trivia answer input (1-4)
-// Returns: 0 for no input, 1-4 for answers
-func (i *InputHandler) CheckTriviaInput() int {
-	if inpututil.IsKeyJustPressed(ebiten.Key1) {
-		return 1
-	}
-	if inpututil.IsKeyJustPressed(ebiten.Key2) {
-		return 2
-	}
-	if inpututil.IsKeyJustPressed(ebiten.Key3) {
-		return 3
-	}
-	if inpututil.IsKeyJustPressed(ebiten.Key4) {
-		return 4
+// CheckTriviaInput checks for trivia answer input (1 up to optionCount)
+// Returns: 0 for no input, 1-optionCount for answers
+func (i *InputHandler) CheckTriviaInput(optionCount int) int {
+	for option := 1; option <= optionCount && option <= 9; option++ {
+		if inpututil.IsKeyJustPressed(ebiten.Key0 + ebiten.Key(option)) {
+			return option
+		}
 	}
 	return 0
 }
 
+// CheckDoneMovingKey checks if the key to stop moving before the step budget runs out was pressed
+func (i *InputHandler) CheckDoneMovingKey() bool {
+	return inpututil.IsKeyJustPressed(ebiten.KeyEnter)
+}
+
+// CheckUndoKey checks if the undo key (U) was just pressed
+func (i *InputHandler) CheckUndoKey() bool {
+	return inpututil.IsKeyJustPressed(ebiten.KeyU)
+}
+
 // CheckRestartKey checks if the restart key was pressed
 func (i *InputHandler) CheckRestartKey() bool {
 	return inpututil.IsKeyJustPressed(ebiten.KeySpace)
@@ -102,6 +115,41 @@ func (ih *InputHandler) CheckXRotateRightKey() bool {
     return inpututil.IsKeyJustPressed(ebiten.KeyR)
 }
 
+// CheckHeatmapKey checks if the heatmap debug toggle key was pressed
+func (ih *InputHandler) CheckHeatmapKey() bool {
+    return inpututil.IsKeyJustPressed(ebiten.KeyH)
+}
+
+// CheckCoordinatesKey checks if the tile coordinates debug toggle key was pressed
+func (ih *InputHandler) CheckCoordinatesKey() bool {
+    return inpututil.IsKeyJustPressed(ebiten.KeyG)
+}
+
+// CheckRevealKey checks if the reveal-the-maze debug toggle key was pressed
+func (ih *InputHandler) CheckRevealKey() bool {
+    return inpututil.IsKeyJustPressed(ebiten.KeyV)
+}
+
+// CheckNextFlavorKey checks if the key to show the next flavor image was pressed
+func (ih *InputHandler) CheckNextFlavorKey() bool {
+    return inpututil.IsKeyJustPressed(ebiten.KeyBracketRight)
+}
+
+// CheckPrevFlavorKey checks if the key to show the previous flavor image was pressed
+func (ih *InputHandler) CheckPrevFlavorKey() bool {
+    return inpututil.IsKeyJustPressed(ebiten.KeyBracketLeft)
+}
+
+// CheckRestartMatchKey checks if the key to restart the match mid-game was pressed
+func (ih *InputHandler) CheckRestartMatchKey() bool {
+    return inpututil.IsKeyJustPressed(ebiten.KeyN)
+}
+
+// CheckYesKey checks if the key that answers yes to a prompt was pressed
+func (ih *InputHandler) CheckYesKey() bool {
+    return inpututil.IsKeyJustPressed(ebiten.KeyY)
+}
+
 // CheckConfirmKey checks if the confirm key was pressed
 func (ih *InputHandler) CheckConfirmKey() bool {
     return inpututil.IsKeyJustPressed(ebiten.KeyEnter)
@@ -112,6 +160,16 @@ func (ih *InputHandler) CheckCancelKey() bool {
     return inpututil.IsKeyJustPressed(ebiten.KeyEscape)
 }
 
+// CheckNextPageKey checks if the key for the next popup page was pressed
+func (ih *InputHandler) CheckNextPageKey() bool {
+    return inpututil.IsKeyJustPressed(ebiten.KeyDown) || inpututil.IsKeyJustPressed(ebiten.KeyPageDown)
+}
+
+// CheckPrevPageKey checks if the key for the previous popup page was pressed
+func (ih *InputHandler) CheckPrevPageKey() bool {
+    return inpututil.IsKeyJustPressed(ebiten.KeyUp) || inpututil.IsKeyJustPressed(ebiten.KeyPageUp)
+}
+
 // CheckActionSelectionInput checks for action selection input (1-9)
 // Returns: 0 for no input, 1-9 for action selection
 func (i *InputHandler) CheckActionSelectionInput() int {
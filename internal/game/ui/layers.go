@@ -0,0 +1,62 @@
+// internal/game/ui/layers.go
+package ui
+
+import (
+    "sort"
+
+    "github.com/hajimehoshi/ebiten/v2"
+)
+
+// Layer is the z-order of a draw call, lower layers are drawn first
+type Layer int
+
+const (
+    FrameLayer   Layer = iota // Section borders and titles
+    MazeLayer                 // Maze tiles
+    EntityLayer               // NPCs and their turn marker
+    PlayerLayer               // The player
+    OverlayLayer              // Pointers and the solution path, drawn over the board
+    PanelLayer                // Side panels such as the flavor image
+    HUDLayer                  // Turn, score and log text
+    PopupLayer                // The action popup
+    MessageLayer              // Action messages, always on top
+)
+
+// drawCall is one queued draw with the order it was added in
+type drawCall struct {
+    layer Layer
+    order int
+    draw  func(screen *ebiten.Image)
+}
+
+// RenderQueue collects draw calls and flushes them in ascending layer order.
+// Calls on the same layer keep the order they were added in.
+// The zero value is ready to use
+type RenderQueue struct {
+    calls []drawCall
+}
+
+// Add queues a draw call on the given layer
+func (q *RenderQueue) Add(layer Layer, draw func(screen *ebiten.Image)) {
+    q.calls = append(q.calls, drawCall{layer: layer, order: len(q.calls), draw: draw})
+}
+
+// Len returns the number of queued draw calls
+func (q *RenderQueue) Len() int {
+    return len(q.calls)
+}
+
+// Flush runs the queued draw calls onto the screen, lowest layer first,
+// and empties the queue
+func (q *RenderQueue) Flush(screen *ebiten.Image) {
+    sort.Slice(q.calls, func(i, j int) bool {
+        if q.calls[i].layer != q.calls[j].layer {
+            return q.calls[i].layer < q.calls[j].layer
+        }
+        return q.calls[i].order < q.calls[j].order
+    })
+    for _, call := range q.calls {
+        call.draw(screen)
+    }
+    q.calls = q.calls[:0]
+}
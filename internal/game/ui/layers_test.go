@@ -0,0 +1,41 @@
+// internal/game/ui/layers_test.go
+package ui
+
+import (
+    "fmt"
+    "testing"
+
+    "github.com/hajimehoshi/ebiten/v2"
+)
+
+func TestFlushDrawsInAscendingLayers(t *testing.T) {
+    var q RenderQueue
+    var drawn []string
+    record := func(name string) func(*ebiten.Image) {
+        return func(*ebiten.Image) { drawn = append(drawn, name) }
+    }
+    
+    // Added out of order, with two calls sharing the entity layer
+    q.Add(MessageLayer, record("message"))
+    q.Add(EntityLayer, record("npc 1"))
+    q.Add(FrameLayer, record("frame"))
+    q.Add(EntityLayer, record("npc 2"))
+    q.Add(MazeLayer, record("maze"))
+    
+    q.Flush(nil)
+    
+    want := []string{"frame", "maze", "npc 1", "npc 2", "message"}
+    if fmt.Sprint(drawn) != fmt.Sprint(want) {
+        t.Errorf("drawn in order %v, want %v", drawn, want)
+    }
+    if q.Len() != 0 {
+        t.Errorf("%d calls left after flushing", q.Len())
+    }
+    
+    // The emptied queue draws nothing the next frame
+    drawn = nil
+    q.Flush(nil)
+    if len(drawn) != 0 {
+        t.Errorf("a second flush drew %v", drawn)
+    }
+}
@@ -19,6 +19,12 @@ type Rect struct {
     X, Y, Width, Height int
 }
 
+// Contains checks if a point lies inside the rectangle
+func (r Rect) Contains(x, y float64) bool {
+    return x >= float64(r.X) && x < float64(r.X+r.Width) &&
+        y >= float64(r.Y) && y < float64(r.Y+r.Height)
+}
+
 type LayoutManager struct {
     Sections map[SectionType]Section
     ScreenWidth, ScreenHeight int
@@ -31,7 +37,7 @@ func NewLayoutManager(screenWidth, screenHeight int) *LayoutManager {
         Rect: Rect{
             X: screenWidth / 2, // Left side of the screen
             Y: 0,
-            Width: screenWidth / 2,
+            Width: screenWidth - (screenWidth / 2),
             Height: screenHeight,
         },
         Border: true,
@@ -43,7 +49,7 @@ func NewLayoutManager(screenWidth, screenHeight int) *LayoutManager {
         Rect: Rect{
             X: 0, // Right side of screen
             Y: 0,
-            Width: screenWidth - (screenWidth / 2),
+            Width: screenWidth / 2, // Odd widths give the extra pixel to the maze
             Height: screenHeight,
         },
         Border: true,
@@ -0,0 +1,30 @@
+// internal/game/ui/layout_test.go
+package ui
+
+import "testing"
+
+func TestCustomScreenSizeFillsTheLayout(t *testing.T) {
+    defer SetScreenSize(DefaultScreenWidth, DefaultScreenHeight)
+    
+    for _, size := range [][2]int{{1024, 768}, {1601, 901}, {MaxScreenWidth, MaxScreenHeight}} {
+        SetScreenSize(size[0], size[1])
+        layout := NewLayoutManager(ScreenWidth, ScreenHeight)
+        
+        maze, flavor := layout.GetSection(MazeSection).Rect, layout.GetSection(FlavorSection).Rect
+        if area := maze.Width*maze.Height + flavor.Width*flavor.Height; area != size[0]*size[1] {
+            t.Errorf("%dx%d: sections cover %d pixels, want %d", size[0], size[1], area, size[0]*size[1])
+        }
+        if flavor.X+flavor.Width != maze.X || maze.X+maze.Width > size[0] {
+            t.Errorf("%dx%d: flavor %+v and maze %+v overlap or leave a gap", size[0], size[1], flavor, maze)
+        }
+    }
+}
+
+func TestScreenSizeIsClamped(t *testing.T) {
+    defer SetScreenSize(DefaultScreenWidth, DefaultScreenHeight)
+    
+    SetScreenSize(100, 100000)
+    if ScreenWidth != MinScreenWidth || ScreenHeight != MaxScreenHeight {
+        t.Errorf("screen size %dx%d, want %dx%d", ScreenWidth, ScreenHeight, MinScreenWidth, MaxScreenHeight)
+    }
+}
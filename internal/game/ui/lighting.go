@@ -0,0 +1,32 @@
+// internal/game/ui/lighting.go
+package ui
+
+import (
+	"image/color"
+	"math"
+)
+
+// MinBrightness is how bright tiles far from the player still are, so the
+// maze stays faintly readable instead of going fully dark
+const MinBrightness = 0.2
+
+// DefaultLightRadius is how many tiles the light reaches before it bottoms out
+const DefaultLightRadius = 5.0
+
+// Brightness returns how lit a tile distance tiles from the player is:
+// 1 on the player, falling off linearly to MinBrightness at radius and
+// staying there beyond it. A radius of 0 or less lights everything fully
+func Brightness(distance, radius float64) float64 {
+	if radius <= 0 {
+		return 1
+	}
+	// Measured up from the minimum, so tiles past the radius get exactly MinBrightness
+	lit := 1 - math.Max(0, math.Min(1, distance/radius))
+	return MinBrightness + (1-MinBrightness)*lit
+}
+
+// lightColor dims a tile color's alpha by the given brightness
+func lightColor(c color.RGBA, brightness float64) color.RGBA {
+	c.A = uint8(float64(c.A) * brightness)
+	return c
+}
@@ -0,0 +1,45 @@
+// internal/game/ui/lighting_test.go
+package ui
+
+import (
+	"image/color"
+	"testing"
+)
+
+func TestBrightnessFallsOffAndClamps(t *testing.T) {
+	previous := Brightness(0, DefaultLightRadius)
+	if previous != 1 {
+		t.Errorf("brightness on the player = %v, want 1", previous)
+	}
+	for distance := 0.5; distance <= 3*DefaultLightRadius; distance += 0.5 {
+		b := Brightness(distance, DefaultLightRadius)
+		if b > previous {
+			t.Errorf("brightness rose from %v to %v at distance %v", previous, b, distance)
+		}
+		if b < MinBrightness {
+			t.Errorf("brightness %v at distance %v is below the minimum", b, distance)
+		}
+		if distance < DefaultLightRadius && b <= MinBrightness {
+			t.Errorf("brightness already bottomed out at distance %v", distance)
+		}
+		if distance >= DefaultLightRadius && b != MinBrightness {
+			t.Errorf("brightness %v past the radius, want it held at %v", b, MinBrightness)
+		}
+		previous = b
+	}
+}
+
+func TestZeroRadiusLightsEverything(t *testing.T) {
+	for _, distance := range []float64{0, 3, 100} {
+		if b := Brightness(distance, 0); b != 1 {
+			t.Errorf("brightness %v at distance %v with no radius, want 1", b, distance)
+		}
+	}
+}
+
+func TestLightColorDimsAlpha(t *testing.T) {
+	c := lightColor(color.RGBA{R: 100, G: 150, B: 200, A: 200}, 0.5)
+	if c != (color.RGBA{R: 100, G: 150, B: 200, A: 100}) {
+		t.Errorf("lightColor = %v, want only the alpha halved", c)
+	}
+}
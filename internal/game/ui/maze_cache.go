@@ -0,0 +1,70 @@
+// internal/game/ui/maze_cache.go
+package ui
+
+import (
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"github.com/JacobCromwell/Mazenasium/internal/game/maze"
+)
+
+// tileCache keeps a picture of the maze's tiles so the grid is drawn with
+// one image per frame instead of several shapes per tile. The picture is
+// only redrawn when the grid reports itself dirty or the view changes
+type tileCache struct {
+	picture  *ebiten.Image
+	maze     *maze.Maze // Maze the picture shows
+	tileSize float64    // Tile size the picture was drawn at
+	view     tileView   // Drawing options the picture was made with
+}
+
+// tileView is the part of MazeDrawOptions that changes how tiles look
+type tileView struct {
+	heatmap     bool
+	wallStyle   WallStyle
+	wallTexture *ebiten.Image
+}
+
+// tileViewOf picks the tile drawing options out of opts
+func tileViewOf(opts MazeDrawOptions) tileView {
+	return tileView{heatmap: opts.Heatmap, wallStyle: opts.WallStyle, wallTexture: opts.WallTexture}
+}
+
+// mazeTiles is the cached picture DrawMaze blits
+var mazeTiles = &tileCache{}
+
+// stale checks if the picture no longer matches what would be drawn
+func (c *tileCache) stale(mazeObj *maze.Maze, view tileView) bool {
+	return c.picture == nil || c.maze != mazeObj || c.tileSize != mazeObj.GetTileSize() ||
+		c.view != view || mazeObj.State.Dirty()
+}
+
+// image returns the picture of the maze's tiles, redrawing it first if the
+// grid has changed since it was last drawn
+func (c *tileCache) image(mazeObj *maze.Maze, opts MazeDrawOptions) *ebiten.Image {
+	view := tileViewOf(opts)
+	if !c.stale(mazeObj, view) {
+		return c.picture
+	}
+
+	// Leave a pixel for the borders on the far edges
+	width, height := mazeObj.PixelSize()
+	w, h := int(math.Ceil(width))+1, int(math.Ceil(height))+1
+	if c.picture == nil || c.picture.Bounds().Dx() != w || c.picture.Bounds().Dy() != h {
+		if c.picture != nil {
+			c.picture.Deallocate()
+		}
+		c.picture = ebiten.NewImage(w, h)
+	}
+	c.picture.Clear()
+
+	drawMazeTiles(c.picture, mazeObj, 0, 0, MazeDrawOptions{
+		Heatmap:     view.heatmap,
+		WallStyle:   view.wallStyle,
+		WallTexture: view.wallTexture,
+	})
+	mazeObj.State.ClearDirty()
+	c.maze, c.tileSize, c.view = mazeObj, mazeObj.GetTileSize(), view
+	return c.picture
+}
@@ -0,0 +1,64 @@
+// internal/game/ui/maze_cache_test.go
+package ui
+
+import (
+	"testing"
+
+	"github.com/JacobCromwell/Mazenasium/internal/game/maze"
+)
+
+// newCacheMaze builds a small maze with an open middle row
+func newCacheMaze() *maze.Maze {
+	state := maze.NewState(7, 3)
+	for x := 1; x < 6; x++ {
+		state.SetTileType(x, 1, maze.Floor)
+	}
+	state.SetTileType(3, 1, maze.Wall)
+	return &maze.Maze{State: state, TileSize: maze.TileSize}
+}
+
+func TestUnchangedMazeReusesTheCachedPicture(t *testing.T) {
+	cache := &tileCache{}
+	mazeObj := newCacheMaze()
+
+	first := cache.image(mazeObj, MazeDrawOptions{})
+	if mazeObj.State.Dirty() {
+		t.Fatal("drawing the picture didn't clear the dirty flag")
+	}
+	if cache.stale(mazeObj, tileView{}) {
+		t.Fatal("the picture is stale straight after drawing it")
+	}
+	if again := cache.image(mazeObj, MazeDrawOptions{}); again != first {
+		t.Error("an unchanged maze got a new picture")
+	}
+}
+
+func TestRotationMarksTheCacheStale(t *testing.T) {
+	cache := &tileCache{}
+	mazeObj := newCacheMaze()
+	cache.image(mazeObj, MazeDrawOptions{})
+
+	mazeObj.State.PerformXRotate(1, 1, 1)
+	if !mazeObj.State.Dirty() || !cache.stale(mazeObj, tileView{}) {
+		t.Error("a rotation didn't mark the picture for redrawing")
+	}
+
+	cache.image(mazeObj, MazeDrawOptions{})
+	if cache.stale(mazeObj, tileView{}) {
+		t.Error("the picture is still stale after redrawing")
+	}
+	if !cache.stale(mazeObj, tileView{heatmap: true}) {
+		t.Error("switching on the heatmap should redraw the picture")
+	}
+}
+
+func BenchmarkCachedMazeDraw(b *testing.B) {
+	cache := &tileCache{}
+	mazeObj := &maze.Maze{State: maze.NewState(41, 41), TileSize: maze.TileSize}
+	cache.image(mazeObj, MazeDrawOptions{})
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		cache.image(mazeObj, MazeDrawOptions{})
+	}
+}
@@ -0,0 +1,69 @@
+// internal/game/ui/maze_fit.go
+package ui
+
+import (
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"github.com/JacobCromwell/Mazenasium/internal/game/maze"
+)
+
+// MazeFooterHeight is the space kept below a fitted maze for the score line
+// and event log
+const MazeFooterHeight = 260
+
+// FitScale returns the uniform scale that shrinks a maze of the given pixel
+// size to fit inside the area. Mazes that already fit are drawn at scale 1
+func FitScale(mazeWidth, mazeHeight float64, area Rect) float64 {
+	if mazeWidth <= 0 || mazeHeight <= 0 || area.Width <= 0 || area.Height <= 0 {
+		return 1
+	}
+	scale := math.Min(float64(area.Width)/mazeWidth, float64(area.Height)/mazeHeight)
+	return math.Min(scale, 1)
+}
+
+// MazeArea returns the part of the maze section a fitted maze may fill:
+// below the title and above the footer
+func MazeArea() Rect {
+	section := NewLayoutManager(ScreenWidth, ScreenHeight).GetSection(MazeSection)
+	return Rect{
+		X:      section.Rect.X,
+		Y:      section.Rect.Y + 40,
+		Width:  section.Rect.Width,
+		Height: section.Rect.Height - 40 - MazeFooterHeight,
+	}
+}
+
+// MazeView returns the screen position of the maze's top-left corner and
+// the scale it is drawn at. With fit set, a maze too big for its section
+// is scaled down and centered in MazeArea; otherwise it is drawn at full
+// size from MazeOrigin
+func MazeView(mazeObj *maze.Maze, fit bool) (x, y, scale float64) {
+	x, y = MazeOrigin(mazeObj)
+	if !fit {
+		return x, y, 1
+	}
+
+	mazeWidth, mazeHeight := mazeObj.PixelSize()
+	area := MazeArea()
+	scale = FitScale(mazeWidth, mazeHeight, area)
+	if scale >= 1 {
+		return x, y, 1
+	}
+	x = float64(area.X) + (float64(area.Width)-mazeWidth*scale)/2
+	y = float64(area.Y)
+	return x, y, scale
+}
+
+// boardTarget returns an offscreen buffer the size of the maze, cleared,
+// for drawing the board before it is scaled onto the screen
+func (r *Renderer) boardTarget(mazeObj *maze.Maze) *ebiten.Image {
+	width, height := mazeObj.PixelSize()
+	w, h := int(math.Ceil(width))+2, int(math.Ceil(height))+2
+	if r.boardBuffer == nil || r.boardBuffer.Bounds().Dx() != w || r.boardBuffer.Bounds().Dy() != h {
+		r.boardBuffer = ebiten.NewImage(w, h)
+	}
+	r.boardBuffer.Clear()
+	return r.boardBuffer
+}
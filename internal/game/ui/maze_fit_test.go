@@ -0,0 +1,52 @@
+// internal/game/ui/maze_fit_test.go
+package ui
+
+import (
+	"testing"
+
+	"github.com/JacobCromwell/Mazenasium/internal/game/maze"
+)
+
+func TestFitScaleShrinksOversizedMazes(t *testing.T) {
+	area := Rect{X: 0, Y: 0, Width: 600, Height: 400}
+	tests := []struct {
+		width, height float64
+	}{
+		{1200, 400},
+		{600, 1600},
+		{3000, 2500},
+		{601, 401},
+	}
+	for _, tt := range tests {
+		scale := FitScale(tt.width, tt.height, area)
+		if scale <= 0 || scale >= 1 {
+			t.Errorf("%vx%v: scale %v, want it shrunk below 1", tt.width, tt.height, scale)
+		}
+		if tt.width*scale > float64(area.Width)+1e-9 || tt.height*scale > float64(area.Height)+1e-9 {
+			t.Errorf("%vx%v: scaled to %vx%v, which doesn't fit %dx%d", tt.width, tt.height, tt.width*scale, tt.height*scale, area.Width, area.Height)
+		}
+	}
+
+	if scale := FitScale(300, 200, area); scale != 1 {
+		t.Errorf("a maze that already fits has scale %v, want 1", scale)
+	}
+}
+
+func TestFittedMazeStaysInsideItsArea(t *testing.T) {
+	mazeObj := &maze.Maze{State: maze.NewState(120, 90), TileSize: maze.TileSize}
+	area := MazeArea()
+
+	x, y, scale := MazeView(mazeObj, true)
+	width, height := mazeObj.PixelSize()
+	if scale >= 1 {
+		t.Fatalf("a %vx%v maze wasn't scaled down for a %dx%d area", width, height, area.Width, area.Height)
+	}
+	if x < float64(area.X) || y < float64(area.Y) ||
+		x+width*scale > float64(area.X+area.Width)+1e-9 || y+height*scale > float64(area.Y+area.Height)+1e-9 {
+		t.Errorf("fitted maze spans (%v,%v) to (%v,%v), outside %+v", x, y, x+width*scale, y+height*scale, area)
+	}
+
+	if _, _, scale := MazeView(mazeObj, false); scale != 1 {
+		t.Errorf("scale %v without fitting, want 1", scale)
+	}
+}
@@ -4,13 +4,159 @@ package ui
 import (
     "github.com/hajimehoshi/ebiten/v2"
     "github.com/hajimehoshi/ebiten/v2/ebitenutil"
+    "github.com/hajimehoshi/ebiten/v2/text"
+    "fmt"
+    "image"
     "image/color"
+    "math"
+    "strings"
     
     "github.com/JacobCromwell/Mazenasium/internal/game/maze"
+    "github.com/JacobCromwell/Mazenasium/internal/game/player"
 )
 
+// MazeDrawOptions controls optional debug rendering modes for DrawMaze
+type MazeDrawOptions struct {
+    Heatmap     bool            // Tint floor tiles by how often they have been visited
+    Preview     *RowPreview     // Ghost of a pending rotation, nil when none
+    PeekGoal    bool            // Point from the player toward the goal
+    Unexplored  *maze.Position  // Nearest unexplored cell to point toward, nil when none
+    Coordinates bool            // Overlay each tile's grid position
+    Reveal      bool            // Cheat view: the whole maze plus the player's solution path
+    GoalGlow    float64         // Strength of the glow around the goal, 0-1
+    LightRadius float64         // Tiles the player's light reaches, 0 for no lighting
+    LightX      float64         // Light source in grid units, the player's center
+    LightY      float64
+    WallStyle   WallStyle       // How wall tiles are drawn
+    WallTexture *ebiten.Image   // Texture for textured walls, nil falls back to solid
+    Trail       []maze.Position // Cells the player took, drawn as a connected trail
+}
+
+// TileBrightness returns how lit the tile at (x, y) is drawn. Every tile is
+// fully lit when lighting is off or the reveal cheat is on
+func (opts MazeDrawOptions) TileBrightness(x, y int) float64 {
+    if !opts.lit() {
+        return 1
+    }
+    distance := math.Hypot(float64(x)+0.5-opts.LightX, float64(y)+0.5-opts.LightY)
+    return Brightness(distance, opts.LightRadius)
+}
+
+// lit checks if the player's light hides part of the maze
+func (opts MazeDrawOptions) lit() bool {
+    return opts.LightRadius > 0 && !opts.Reveal
+}
+
+// TileLabel returns the debug label for a tile: its grid position
+func TileLabel(tile *maze.Tile) string {
+    return fmt.Sprintf("%d,%d", tile.X, tile.Y)
+}
+
+// RowPreview is a row of tiles as it will look after a pending rotation
+type RowPreview struct {
+    Y     int          // Row the preview applies to
+    Tiles []*maze.Tile // Tiles in their post-rotation order
+}
+
+// HeatmapColor returns the tint for a tile visited count times,
+// scaled against the most visited tile. Unvisited tiles get no tint
+func HeatmapColor(count, maxCount int) color.RGBA {
+    if count <= 0 || maxCount <= 0 {
+        return color.RGBA{0, 0, 0, 0}
+    }
+    
+    // Blend from yellow to red as the tile gets busier
+    intensity := float64(count) / float64(maxCount)
+    return color.RGBA{
+        255,
+        uint8(220 * (1 - intensity)),
+        0,
+        uint8(60 + 140*intensity),
+    }
+}
+
 // DrawMaze renders the maze grid on the screen
-func DrawMaze(screen *ebiten.Image, mazeObj *maze.Maze, offsetX, offsetY float64) {
+func DrawMaze(screen *ebiten.Image, mazeObj *maze.Maze, offsetX, offsetY float64, opts MazeDrawOptions) {
+    tileSize := mazeObj.GetTileSize()
+    
+    // Lighting follows the player every frame, so it can't come from the
+    // cached picture of the grid
+    if opts.lit() {
+        drawMazeTiles(screen, mazeObj, offsetX, offsetY, opts)
+    } else {
+        op := &ebiten.DrawImageOptions{}
+        op.GeoM.Translate(offsetX, offsetY)
+        screen.DrawImage(mazeTiles.image(mazeObj, opts), op)
+    }
+    
+    // Debug overlay, skipped for tiles that are off screen
+    if opts.Coordinates {
+        screenBounds := screen.Bounds()
+        for y := 0; y < mazeObj.State.Height; y++ {
+            for x := 0; x < mazeObj.State.Width; x++ {
+                tile := mazeObj.State.GetTile(x, y)
+                tileX := float64(x) * tileSize + offsetX
+                tileY := float64(y) * tileSize + offsetY
+                if tile != nil && tileOnScreen(screenBounds, tileX, tileY, tileSize) {
+                    drawTileLabel(screen, tile, tileX, tileY)
+                }
+            }
+        }
+    }
+    
+    // Glow around wherever the goal tile is now, rotations may have moved it
+    if goal, ok := mazeObj.State.FindGoal(); ok && opts.GoalGlow > 0 {
+        goalX := float64(goal.X) * tileSize + offsetX
+        goalY := float64(goal.Y) * tileSize + offsetY
+        drawGoalGlow(screen, goalX, goalY, tileSize, opts.GoalGlow)
+    }
+    
+    if opts.Preview != nil {
+        drawRowPreview(screen, mazeObj, opts.Preview, offsetX, offsetY)
+    }
+    
+    if len(opts.Trail) > 0 {
+        drawTrail(screen, mazeObj, opts.Trail, offsetX, offsetY)
+    }
+}
+
+// drawTrail draws the route the player took as lines between the centers of
+// the cells they stepped on. Jumps between cells that aren't neighbours, from
+// teleporters, traps and swaps, are left unjoined so the trail doesn't cut
+// through walls
+func drawTrail(screen *ebiten.Image, mazeObj *maze.Maze, trail []maze.Position, offsetX, offsetY float64) {
+    tileSize := mazeObj.GetTileSize()
+    trailColor := color.RGBA{255, 140, 0, 220}
+    center := func(cell maze.Position) (float64, float64) {
+        return offsetX + float64(cell.X)*tileSize + tileSize/2, offsetY + float64(cell.Y)*tileSize + tileSize/2
+    }
+    
+    for i, cell := range trail {
+        x, y := center(cell)
+        ebitenutil.DrawRect(screen, x-2, y-2, 4, 4, trailColor)
+        if i == 0 {
+            continue
+        }
+        prev := trail[i-1]
+        if prev.ManhattanDistance(cell) != 1 {
+            continue
+        }
+        prevX, prevY := center(prev)
+        ebitenutil.DrawLine(screen, prevX, prevY, x, y, trailColor)
+    }
+}
+
+// drawMazeTiles draws every tile of the grid: its fill, heatmap tint,
+// highlight outline and border
+func drawMazeTiles(screen *ebiten.Image, mazeObj *maze.Maze, offsetX, offsetY float64, opts MazeDrawOptions) {
+    // Busiest tile for scaling the heatmap
+    maxVisits := 0
+    if opts.Heatmap {
+        maxVisits = mazeObj.State.MaxVisitCount()
+    }
+    
+    tileSize := mazeObj.GetTileSize()
+    
     // For each tile in the maze state
     for y := 0; y < mazeObj.State.Height; y++ {
         for x := 0; x < mazeObj.State.Width; x++ {
@@ -20,8 +166,8 @@ func DrawMaze(screen *ebiten.Image, mazeObj *maze.Maze, offsetX, offsetY float64
             }
             
             // Calculate tile position
-            tileX := float64(x) * maze.TileSize + offsetX
-            tileY := float64(y) * maze.TileSize + offsetY
+            tileX := float64(x) * tileSize + offsetX
+            tileY := float64(y) * tileSize + offsetY
             
             // Determine tile color based on type
             var tileColor color.RGBA
@@ -30,12 +176,30 @@ func DrawMaze(screen *ebiten.Image, mazeObj *maze.Maze, offsetX, offsetY float64
                 tileColor = color.RGBA{70, 70, 70, 255}
             case maze.Goal:
                 tileColor = color.RGBA{200, 0, 200, 255} // Purple goal
+            case maze.Trap:
+                tileColor = color.RGBA{150, 40, 40, 255} // Dark red trap
+            case maze.Teleporter:
+                tileColor = color.RGBA{0, 170, 190, 255} // Teal teleporter
             default: // Floor
                 tileColor = color.RGBA{200, 200, 200, 100}
             }
             
+            // Fade tiles out with distance from the player's light
+            if opts.lit() {
+                tileColor = lightColor(tileColor, opts.TileBrightness(x, y))
+            }
+            
             // Draw the tile
-            ebitenutil.DrawRect(screen, tileX, tileY, maze.TileSize, maze.TileSize, tileColor)
+            if tile.Type == maze.Wall {
+                drawWall(screen, tileX, tileY, tileSize, opts.WallStyle, opts.WallTexture, tileColor)
+            } else {
+                ebitenutil.DrawRect(screen, tileX, tileY, tileSize, tileSize, tileColor)
+            }
+            
+            // Tint walkable tiles by visit frequency in heatmap mode
+            if opts.Heatmap && tile.Type != maze.Wall && tile.VisitCount > 0 {
+                ebitenutil.DrawRect(screen, tileX, tileY, tileSize, tileSize, HeatmapColor(tile.VisitCount, maxVisits))
+            }
             
             // Draw highlighted tile with a 2px red outline instead of filling
             if tile.Highlighted {
@@ -43,18 +207,129 @@ func DrawMaze(screen *ebiten.Image, mazeObj *maze.Maze, offsetX, offsetY float64
                 highlightColor := color.RGBA{255, 0, 0, 255} // Red outline
                 
                 // Draw 2px outlines
-                ebitenutil.DrawRect(screen, tileX, tileY, maze.TileSize, 2, highlightColor) // Top
-                ebitenutil.DrawRect(screen, tileX, tileY, 2, maze.TileSize, highlightColor) // Left
-                ebitenutil.DrawRect(screen, tileX+maze.TileSize-2, tileY, 2, maze.TileSize, highlightColor) // Right
-                ebitenutil.DrawRect(screen, tileX, tileY+maze.TileSize-2, maze.TileSize, 2, highlightColor) // Bottom
+                ebitenutil.DrawRect(screen, tileX, tileY, tileSize, 2, highlightColor) // Top
+                ebitenutil.DrawRect(screen, tileX, tileY, 2, tileSize, highlightColor) // Left
+                ebitenutil.DrawRect(screen, tileX+tileSize-2, tileY, 2, tileSize, highlightColor) // Right
+                ebitenutil.DrawRect(screen, tileX, tileY+tileSize-2, tileSize, 2, highlightColor) // Bottom
             }
             
             // Draw tile border
             borderColor := color.RGBA{100, 100, 100, 255}
-            ebitenutil.DrawLine(screen, tileX, tileY, tileX+maze.TileSize, tileY, borderColor)
-            ebitenutil.DrawLine(screen, tileX, tileY, tileX, tileY+maze.TileSize, borderColor)
-            ebitenutil.DrawLine(screen, tileX+maze.TileSize, tileY, tileX+maze.TileSize, tileY+maze.TileSize, borderColor)
-            ebitenutil.DrawLine(screen, tileX, tileY+maze.TileSize, tileX+maze.TileSize, tileY+maze.TileSize, borderColor)
+            ebitenutil.DrawLine(screen, tileX, tileY, tileX+tileSize, tileY, borderColor)
+            ebitenutil.DrawLine(screen, tileX, tileY, tileX, tileY+tileSize, borderColor)
+            ebitenutil.DrawLine(screen, tileX+tileSize, tileY, tileX+tileSize, tileY+tileSize, borderColor)
+            ebitenutil.DrawLine(screen, tileX, tileY+tileSize, tileX+tileSize, tileY+tileSize, borderColor)
+        }
+    }
+}
+
+// drawGoalGlow draws a translucent halo around the goal tile that grows and
+// brightens with glow
+func drawGoalGlow(screen *ebiten.Image, tileX, tileY, tileSize, glow float64) {
+    spread := tileSize * 0.3 * glow
+    glowColor := color.RGBA{255, 120, 255, uint8(30 + 70*glow)}
+    ebitenutil.DrawRect(screen, tileX-spread, tileY-spread, tileSize+spread*2, tileSize+spread*2, glowColor)
+}
+
+// tileOnScreen checks if any part of a tile falls within the screen
+func tileOnScreen(bounds image.Rectangle, tileX, tileY, tileSize float64) bool {
+    return tileX+tileSize > float64(bounds.Min.X) && tileX < float64(bounds.Max.X) &&
+        tileY+tileSize > float64(bounds.Min.Y) && tileY < float64(bounds.Max.Y)
+}
+
+// drawTileLabel writes a tile's coordinates at unscaled size so they fit,
+// with x on the first line and y on the second
+func drawTileLabel(screen *ebiten.Image, tile *maze.Tile, tileX, tileY float64) {
+    labelColor := color.RGBA{255, 255, 255, 220}
+    parts := strings.SplitN(TileLabel(tile), ",", 2)
+    for i, part := range parts {
+        text.Draw(screen, part, DefaultFont, int(tileX)+2, int(tileY)+11+i*12, labelColor)
+    }
+}
+
+// drawRowPreview draws a dimmed copy of the post-rotation row over the row
+// above the affected one (or below it, for the top row)
+func drawRowPreview(screen *ebiten.Image, mazeObj *maze.Maze, preview *RowPreview, offsetX, offsetY float64) {
+    tileSize := mazeObj.GetTileSize()
+    
+    ghostY := preview.Y - 1
+    if ghostY < 0 {
+        ghostY = preview.Y + 1
+    }
+    tileY := float64(ghostY) * tileSize + offsetY
+    
+    for x, tile := range preview.Tiles {
+        if tile == nil {
+            continue
         }
+        tileX := float64(x) * tileSize + offsetX
+        
+        // Walls are drawn as dark ghosts, everything else as light ones
+        ghostColor := color.RGBA{220, 220, 255, 90}
+        if tile.IsWall() {
+            ghostColor = color.RGBA{20, 20, 40, 170}
+        }
+        ebitenutil.DrawRect(screen, tileX+3, tileY+3, tileSize-6, tileSize-6, ghostColor)
+    }
+}
+
+// drawSolutionPath marks every cell on the player's shortest path to the goal.
+// It only reads the grid, so revealing never changes the match
+func drawSolutionPath(screen *ebiten.Image, mazeObj *maze.Maze, playerObj *player.Player, offsetX, offsetY float64) {
+    goal, ok := mazeObj.State.FindGoal()
+    if !ok {
+        return
+    }
+    
+    playerGridX, playerGridY := playerObj.GetGridPosition()
+    path := mazeObj.State.ShortestPath(maze.Position{X: playerGridX, Y: playerGridY}, goal)
+    tileSize := mazeObj.GetTileSize()
+    pathColor := color.RGBA{0, 220, 120, 200}
+    for _, cell := range path {
+        centerX := offsetX + float64(cell.X)*tileSize + tileSize/2
+        centerY := offsetY + float64(cell.Y)*tileSize + tileSize/2
+        ebitenutil.DrawRect(screen, centerX-3, centerY-3, 6, 6, pathColor)
+    }
+}
+
+// drawGoalPointer draws an arrow from the player toward the goal tile
+func drawGoalPointer(screen *ebiten.Image, mazeObj *maze.Maze, playerObj *player.Player, offsetX, offsetY float64) {
+    // Look the goal up on the live grid, rotations may have moved it
+    goal, ok := mazeObj.State.FindGoal()
+    if !ok {
+        return
+    }
+    drawPointer(screen, mazeObj, playerObj, goal, color.RGBA{255, 215, 0, 255}, offsetX, offsetY)
+}
+
+// drawPointer draws an arrow of the given color from the player toward a cell
+func drawPointer(screen *ebiten.Image, mazeObj *maze.Maze, playerObj *player.Player, target maze.Position, arrowColor color.RGBA, offsetX, offsetY float64) {
+    tileSize := mazeObj.GetTileSize()
+    playerX, playerY := playerObj.GetPosition()
+    fromX := offsetX + playerX + tileSize/2
+    fromY := offsetY + playerY + tileSize/2
+    toX := offsetX + float64(target.X)*tileSize + tileSize/2
+    toY := offsetY + float64(target.Y)*tileSize + tileSize/2
+    
+    dx, dy := toX-fromX, toY-fromY
+    length := math.Hypot(dx, dy)
+    if length < tileSize {
+        return // Standing next to the target already
+    }
+    
+    // Arrow of two tiles pointing in the target's direction
+    dirX, dirY := dx/length, dy/length
+    arrowLength := tileSize * 2
+    tipX := fromX + dirX*arrowLength
+    tipY := fromY + dirY*arrowLength
+    ebitenutil.DrawLine(screen, fromX, fromY, tipX, tipY, arrowColor)
+    
+    // Arrow head
+    headLength := tileSize / 2
+    for _, angle := range []float64{math.Pi * 5 / 6, -math.Pi * 5 / 6} {
+        sin, cos := math.Sincos(angle)
+        headX := tipX + (dirX*cos-dirY*sin)*headLength
+        headY := tipY + (dirX*sin+dirY*cos)*headLength
+        ebitenutil.DrawLine(screen, tipX, tipY, headX, headY, arrowColor)
     }
-}
\ No newline at end of file
+}
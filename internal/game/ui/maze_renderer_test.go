@@ -0,0 +1,53 @@
+// internal/game/ui/maze_renderer_test.go
+package ui
+
+import (
+    "fmt"
+    "testing"
+    
+    "github.com/JacobCromwell/Mazenasium/internal/game/maze"
+)
+
+func TestTileLabelMatchesGridPosition(t *testing.T) {
+    state := maze.NewState(7, 5)
+    for x := 1; x < 6; x++ {
+        state.SetTileType(x, 2, maze.Floor)
+    }
+    state.SetTileType(4, 2, maze.Wall)
+    
+    check := func(when string) {
+        for y := 0; y < state.Height; y++ {
+            for x := 0; x < state.Width; x++ {
+                if got, want := TileLabel(state.GetTile(x, y)), fmt.Sprintf("%d,%d", x, y); got != want {
+                    t.Errorf("%s: tile at (%d,%d) labelled %q, want %q", when, x, y, got, want)
+                }
+            }
+        }
+    }
+    
+    check("after generation")
+    
+    // Rotated tiles are labelled by where they are now
+    state.PerformXRotate(1, 2, 1)
+    check("after a rotation")
+}
+
+func TestRevealIgnoresTheLight(t *testing.T) {
+    opts := MazeDrawOptions{LightRadius: 2, LightX: 1.5, LightY: 1.5}
+    if got := opts.TileBrightness(1, 1); got != 1 {
+        t.Errorf("the player's own tile has brightness %v, want 1", got)
+    }
+    if got := opts.TileBrightness(10, 10); got > MinBrightness+1e-9 {
+        t.Errorf("a tile out of the light has brightness %v, want %v", got, MinBrightness)
+    }
+    
+    opts.Reveal = true
+    for _, cell := range [][2]int{{1, 1}, {4, 1}, {10, 10}} {
+        if got := opts.TileBrightness(cell[0], cell[1]); got != 1 {
+            t.Errorf("revealed tile (%d,%d) has brightness %v, want fully lit", cell[0], cell[1], got)
+        }
+    }
+    if opts.lit() {
+        t.Error("revealing the maze should bypass the lit drawing path")
+    }
+}
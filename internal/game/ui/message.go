@@ -0,0 +1,91 @@
+// internal/game/ui/message.go
+package ui
+
+import (
+	"image/color"
+)
+
+// TicksPerSecond is the update rate message durations in frames are counted at
+const TicksPerSecond = 60
+
+// SecondsToFrames converts a duration in seconds to whole update frames
+func SecondsToFrames(seconds float64) int {
+	return int(seconds*TicksPerSecond + 0.5)
+}
+
+// FramesToSeconds converts a number of update frames to seconds
+func FramesToSeconds(frames int) float64 {
+	return float64(frames) / TicksPerSecond
+}
+
+// MessageKind picks how an action message is styled
+type MessageKind int
+
+const (
+	InfoMessage    MessageKind = iota // Neutral feedback and prompts
+	SuccessMessage                    // Something worked
+	WarningMessage                    // Something went against the player, such as a trap
+	ErrorMessage                      // The request couldn't be carried out
+)
+
+// Color returns the background color of the message box for the kind
+func (k MessageKind) Color() color.RGBA {
+	switch k {
+	case SuccessMessage:
+		return color.RGBA{20, 110, 40, 200}
+	case WarningMessage:
+		return color.RGBA{160, 100, 0, 200}
+	case ErrorMessage:
+		return color.RGBA{150, 20, 20, 200}
+	default:
+		return color.RGBA{0, 0, 0, 180}
+	}
+}
+
+// MaxQueuedMessages is how many messages can wait behind the one on screen,
+// the oldest waiting message is dropped when another arrives
+const MaxQueuedMessages = 4
+
+// queuedMessage is an action message waiting for its turn on screen
+type queuedMessage struct {
+	text    string
+	seconds float64
+	kind    MessageKind
+}
+
+// ShowMessage shows an action message of the given kind.
+// seconds is how long it stays up, 0 keeps the message until replaced.
+// While a timed message is up the new one waits in a queue and is shown
+// once the current one runs out
+func (r *Renderer) ShowMessage(msg string, seconds float64, kind MessageKind) {
+	if r.actionMsg != "" && r.actionTimer > 0 {
+		if len(r.msgQueue) >= MaxQueuedMessages {
+			r.msgQueue = r.msgQueue[1:]
+		}
+		r.msgQueue = append(r.msgQueue, queuedMessage{text: msg, seconds: seconds, kind: kind})
+		return
+	}
+	r.actionMsg = msg
+	r.actionTimer = seconds
+	r.actionKind = kind
+}
+
+// QueuedMessages returns how many messages are waiting behind the current one
+func (r *Renderer) QueuedMessages() int {
+	return len(r.msgQueue)
+}
+
+// nextMessage moves the oldest queued message on screen, or clears the
+// message when nothing is waiting
+func (r *Renderer) nextMessage() {
+	if len(r.msgQueue) == 0 {
+		r.actionMsg = ""
+		r.actionTimer = 0
+		return
+	}
+	next := r.msgQueue[0]
+	r.msgQueue = r.msgQueue[1:]
+	r.actionMsg = next.text
+	r.actionTimer = next.seconds
+	r.actionKind = next.kind
+}
@@ -0,0 +1,105 @@
+// internal/game/ui/message_test.go
+package ui
+
+import (
+	"image/color"
+	"testing"
+)
+
+func TestSecondsToFrames(t *testing.T) {
+	tests := []struct {
+		seconds float64
+		frames  int
+	}{
+		{0, 0},
+		{1, 60},
+		{1.5, 90},
+		{0.5, 30},
+		{0.01, 1}, // Rounded to the nearest frame
+	}
+	for _, tt := range tests {
+		if got := SecondsToFrames(tt.seconds); got != tt.frames {
+			t.Errorf("SecondsToFrames(%v) = %d, want %d", tt.seconds, got, tt.frames)
+		}
+		if tt.seconds >= 0.5 {
+			if back := FramesToSeconds(tt.frames); back != tt.seconds {
+				t.Errorf("FramesToSeconds(%d) = %v, want %v", tt.frames, back, tt.seconds)
+			}
+		}
+	}
+}
+
+func TestMessageKindColors(t *testing.T) {
+	tests := []struct {
+		kind MessageKind
+		want color.RGBA
+	}{
+		{InfoMessage, color.RGBA{0, 0, 0, 180}},
+		{SuccessMessage, color.RGBA{20, 110, 40, 200}},
+		{WarningMessage, color.RGBA{160, 100, 0, 200}},
+		{ErrorMessage, color.RGBA{150, 20, 20, 200}},
+	}
+	for _, tt := range tests {
+		if got := tt.kind.Color(); got != tt.want {
+			t.Errorf("kind %d colored %v, want %v", tt.kind, got, tt.want)
+		}
+	}
+}
+
+func TestShowMessageKeepsItsKind(t *testing.T) {
+	r := NewRenderer()
+	r.ShowMessage("Wall in the way", 1, ErrorMessage)
+	if r.actionMsg != "Wall in the way" || r.actionKind != ErrorMessage || r.actionTimer != 1 {
+		t.Errorf("showing %q as kind %d for %vs, want the error for 1s", r.actionMsg, r.actionKind, r.actionTimer)
+	}
+}
+
+func TestQueuedMessagesShowInOrderAndDrain(t *testing.T) {
+	r := NewRenderer()
+	r.ShowMessage("first", 1, InfoMessage)
+	r.ShowMessage("second", 1, SuccessMessage)
+	r.ShowMessage("third", 0.5, WarningMessage)
+	if r.actionMsg != "first" || r.QueuedMessages() != 2 {
+		t.Fatalf("showing %q with %d queued, want first with 2 queued", r.actionMsg, r.QueuedMessages())
+	}
+
+	shown := []string{r.actionMsg}
+	for frame := 0; frame < 300 && r.actionMsg != ""; frame++ {
+		r.UpdateActionTimer(1.0 / 60)
+		if r.actionMsg != "" && r.actionMsg != shown[len(shown)-1] {
+			shown = append(shown, r.actionMsg)
+		}
+	}
+
+	want := []string{"first", "second", "third"}
+	if len(shown) != len(want) {
+		t.Fatalf("messages shown %v, want %v", shown, want)
+	}
+	for i := range want {
+		if shown[i] != want[i] {
+			t.Errorf("messages shown %v, want %v", shown, want)
+			break
+		}
+	}
+	if r.actionMsg != "" || r.QueuedMessages() != 0 {
+		t.Errorf("%q still up with %d queued after 5 seconds", r.actionMsg, r.QueuedMessages())
+	}
+}
+
+func TestFullQueueDropsTheOldestWaitingMessage(t *testing.T) {
+	r := NewRenderer()
+	r.ShowMessage("on screen", 1, InfoMessage)
+	for i := 0; i <= MaxQueuedMessages; i++ {
+		r.ShowMessage(string(rune('a'+i)), 1, InfoMessage)
+	}
+
+	if r.QueuedMessages() != MaxQueuedMessages {
+		t.Fatalf("%d messages queued, want the cap of %d", r.QueuedMessages(), MaxQueuedMessages)
+	}
+	if r.msgQueue[0].text != "b" {
+		t.Errorf("oldest waiting message is %q, want a dropped and b next", r.msgQueue[0].text)
+	}
+	if r.actionMsg != "on screen" {
+		t.Errorf("the message on screen changed to %q", r.actionMsg)
+	}
+}
@@ -0,0 +1,62 @@
+// internal/game/ui/shake.go
+package ui
+
+import (
+	"math/rand"
+)
+
+const (
+	ShakeFrames           = 24 // How long a screen shake lasts (~0.4s at 60 FPS)
+	TrapShakeIntensity    = 8  // Offset in pixels when stepping on a trap
+	PenaltyShakeIntensity = 5  // Offset in pixels for a wrong trivia answer
+)
+
+// ScreenShake jolts the whole screen briefly, decaying to rest
+type ScreenShake struct {
+	Enabled   bool    // Accessibility toggle - no shaking when false
+	intensity float64 // Maximum offset in pixels at the start of the shake
+	remaining int     // Frames left in the current shake
+}
+
+// NewScreenShake creates an idle, enabled screen shake
+func NewScreenShake() *ScreenShake {
+	return &ScreenShake{Enabled: true}
+}
+
+// Start begins a shake with the given maximum offset in pixels
+func (s *ScreenShake) Start(intensity float64) {
+	if !s.Enabled {
+		return
+	}
+	s.intensity = intensity
+	s.remaining = ShakeFrames
+}
+
+// Update advances the shake by one frame
+func (s *ScreenShake) Update() {
+	if s.remaining > 0 {
+		s.remaining--
+	}
+}
+
+// IsActive checks if the screen is currently shaking
+func (s *ScreenShake) IsActive() bool {
+	return s.Enabled && s.remaining > 0
+}
+
+// Magnitude returns the current maximum offset, decaying linearly to zero
+func (s *ScreenShake) Magnitude() float64 {
+	if !s.IsActive() {
+		return 0
+	}
+	return s.intensity * float64(s.remaining) / float64(ShakeFrames)
+}
+
+// Offset returns a random translation within the current magnitude
+func (s *ScreenShake) Offset() (float64, float64) {
+	magnitude := s.Magnitude()
+	if magnitude == 0 {
+		return 0, 0
+	}
+	return (rand.Float64()*2 - 1) * magnitude, (rand.Float64()*2 - 1) * magnitude
+}
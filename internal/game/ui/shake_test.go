@@ -0,0 +1,47 @@
+// internal/game/ui/shake_test.go
+package ui
+
+import (
+	"math"
+	"testing"
+)
+
+func TestShakeMagnitudeDecaysToZero(t *testing.T) {
+	s := NewScreenShake()
+	s.Start(TrapShakeIntensity)
+
+	previous := s.Magnitude()
+	if previous != TrapShakeIntensity {
+		t.Fatalf("Magnitude() = %v at the start, want %v", previous, float64(TrapShakeIntensity))
+	}
+
+	for frame := 1; frame <= ShakeFrames; frame++ {
+		s.Update()
+		magnitude := s.Magnitude()
+		if magnitude > previous {
+			t.Fatalf("frame %d: magnitude grew from %v to %v", frame, previous, magnitude)
+		}
+		x, y := s.Offset()
+		if math.Abs(x) > magnitude || math.Abs(y) > magnitude {
+			t.Fatalf("frame %d: offset (%v,%v) exceeds magnitude %v", frame, x, y, magnitude)
+		}
+		previous = magnitude
+	}
+
+	if s.IsActive() || s.Magnitude() != 0 {
+		t.Errorf("after %d frames: active %v, magnitude %v, want settled", ShakeFrames, s.IsActive(), s.Magnitude())
+	}
+	if x, y := s.Offset(); x != 0 || y != 0 {
+		t.Errorf("Offset() = (%v,%v) once settled, want (0,0)", x, y)
+	}
+}
+
+func TestDisabledShakeNeverMoves(t *testing.T) {
+	s := NewScreenShake()
+	s.Enabled = false
+	s.Start(TrapShakeIntensity)
+
+	if s.IsActive() || s.Magnitude() != 0 {
+		t.Error("a disabled shake should stay still")
+	}
+}
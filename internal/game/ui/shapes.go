@@ -0,0 +1,72 @@
+// internal/game/ui/shapes.go
+package ui
+
+import (
+	"image"
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+
+	"github.com/JacobCromwell/Mazenasium/internal/game/npc"
+)
+
+// whitePixel is the source image for filled vector paths
+var whitePixel = func() *ebiten.Image {
+	img := ebiten.NewImage(3, 3)
+	img.Fill(color.White)
+	return img.SubImage(image.Rect(1, 1, 2, 2)).(*ebiten.Image)
+}()
+
+// shapeDrawer fills a shape inside the square at (x, y) with the given size
+type shapeDrawer func(screen *ebiten.Image, x, y, size float64, clr color.RGBA)
+
+// shapeDrawers maps each NPC shape to the primitive that draws it
+var shapeDrawers = map[npc.Shape]shapeDrawer{
+	npc.Square:   drawSquare,
+	npc.Circle:   drawCircle,
+	npc.Triangle: drawTriangle,
+}
+
+// DrawShape draws a filled shape inside the square at (x, y) with the given size.
+// Unknown shapes are drawn as squares
+func DrawShape(screen *ebiten.Image, shape npc.Shape, x, y, size float64, clr color.RGBA) {
+	draw, ok := shapeDrawers[shape]
+	if !ok {
+		draw = shapeDrawers[npc.Square]
+	}
+	draw(screen, x, y, size, clr)
+}
+
+// drawSquare fills the square at (x, y)
+func drawSquare(screen *ebiten.Image, x, y, size float64, clr color.RGBA) {
+	ebitenutil.DrawRect(screen, x, y, size, size, clr)
+}
+
+// drawCircle fills the circle inscribed in the square at (x, y)
+func drawCircle(screen *ebiten.Image, x, y, size float64, clr color.RGBA) {
+	radius := size / 2
+	vector.DrawFilledCircle(screen, float32(x+radius), float32(y+radius), float32(radius), clr, true)
+}
+
+// drawTriangle fills an upward-pointing triangle inside the square at (x, y)
+func drawTriangle(screen *ebiten.Image, x, y, size float64, clr color.RGBA) {
+	var path vector.Path
+	path.MoveTo(float32(x+size/2), float32(y))
+	path.LineTo(float32(x+size), float32(y+size))
+	path.LineTo(float32(x), float32(y+size))
+	path.Close()
+
+	vertices, indices := path.AppendVerticesAndIndicesForFilling(nil, nil)
+	for i := range vertices {
+		vertices[i].SrcX, vertices[i].SrcY = 1, 1
+		vertices[i].ColorR = float32(clr.R) / 255
+		vertices[i].ColorG = float32(clr.G) / 255
+		vertices[i].ColorB = float32(clr.B) / 255
+		vertices[i].ColorA = float32(clr.A) / 255
+	}
+
+	op := &ebiten.DrawTrianglesOptions{AntiAlias: true}
+	screen.DrawTriangles(vertices, indices, whitePixel, op)
+}
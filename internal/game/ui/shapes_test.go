@@ -0,0 +1,45 @@
+// internal/game/ui/shapes_test.go
+package ui
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"github.com/JacobCromwell/Mazenasium/internal/game/npc"
+)
+
+func TestDrawShapeUsesTheShapesPrimitive(t *testing.T) {
+	original := shapeDrawers
+	defer func() { shapeDrawers = original }()
+
+	var drawn []npc.Shape
+	shapeDrawers = map[npc.Shape]shapeDrawer{}
+	for shape := range original {
+		shape := shape
+		shapeDrawers[shape] = func(_ *ebiten.Image, x, y, size float64, _ color.RGBA) {
+			if x != 4 || y != 8 || size != 16 {
+				t.Errorf("shape %v drawn at (%v,%v) size %v, want (4,8) size 16", shape, x, y, size)
+			}
+			drawn = append(drawn, shape)
+		}
+	}
+
+	tests := []struct {
+		shape npc.Shape
+		want  npc.Shape
+	}{
+		{npc.Square, npc.Square},
+		{npc.Circle, npc.Circle},
+		{npc.Triangle, npc.Triangle},
+		{npc.Shape(99), npc.Square}, // Unknown shapes fall back to squares
+	}
+	for _, tt := range tests {
+		drawn = nil
+		DrawShape(nil, tt.shape, 4, 8, 16, color.RGBA{255, 0, 0, 255})
+		if len(drawn) != 1 || drawn[0] != tt.want {
+			t.Errorf("DrawShape(%v) used %v, want the %v primitive", tt.shape, drawn, tt.want)
+		}
+	}
+}
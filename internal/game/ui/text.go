@@ -5,6 +5,7 @@ import (
 	"image/color"
 
 	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
 	"github.com/hajimehoshi/ebiten/v2/text"
 	"golang.org/x/image/font"
 	"golang.org/x/image/font/basicfont"
@@ -22,10 +23,48 @@ var (
 	
 	// OutlineColor is the color for text outlines
 	OutlineColor = color.RGBA{0, 0, 0, 255} // Black
+	
+	// HighContrastTextColor and HighContrastBackground are used in high contrast mode
+	HighContrastTextColor  = color.RGBA{255, 255, 255, 255}
+	HighContrastBackground = color.RGBA{0, 0, 0, 230}
 )
 
-// DrawTextWithOutline draws text with a 1px outline
-func DrawTextWithOutline(screen *ebiten.Image, s string, x, y int, textColor, outlineColor color.Color) {
+// TextStyle holds the colors used to draw a string
+type TextStyle struct {
+	Text       color.Color
+	Outline    color.Color
+	Background color.Color // Box drawn behind the text, nil for none
+}
+
+// ResolveTextStyle returns the colors to actually draw with. High contrast
+// replaces the requested colors with white text on a solid dark box
+func ResolveTextStyle(textColor, outlineColor color.Color, highContrast bool) TextStyle {
+	if highContrast {
+		return TextStyle{
+			Text:       HighContrastTextColor,
+			Outline:    color.RGBA{0, 0, 0, 0},
+			Background: HighContrastBackground,
+		}
+	}
+	return TextStyle{Text: textColor, Outline: outlineColor}
+}
+
+// drawTextBackground fills a box behind text of the given unscaled size
+func drawTextBackground(screen *ebiten.Image, x, y, w, h int, clr color.Color) {
+	padding := 2.0
+	ebitenutil.DrawRect(
+		screen,
+		float64(x)-padding,
+		float64(y)-padding,
+		float64(w)*FontScale+padding*2,
+		float64(h)*FontScale+padding*2,
+		clr,
+	)
+}
+
+// DrawTextWithOutline draws text with a 1px outline, or in the high
+// contrast style when highContrast is set
+func DrawTextWithOutline(screen *ebiten.Image, s string, x, y int, textColor, outlineColor color.Color, highContrast bool) {
 	if s == "" {
 		return // Don't try to render an empty string
 	}
@@ -47,51 +86,91 @@ func DrawTextWithOutline(screen *ebiten.Image, s string, x, y int, textColor, ou
 		h = 1
 	}
 	
-	// Create a temporary image for rendering the text with outline
+	// Apply high contrast mode, drawing its box behind the text
+	style := ResolveTextStyle(textColor, outlineColor, highContrast)
+	textColor, outlineColor = style.Text, style.Outline
+	if style.Background != nil {
+		drawTextBackground(screen, x, y, w, h, style.Background)
+	}
+	
+	// Reuse the image from an earlier frame, rasterizing only new strings
+	textImage := outlinedText(s, w, h, -bounds.Min.Y, textColor, outlineColor)
+	
+	// Draw the scaled text to the screen
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Scale(FontScale, FontScale)
+	op.GeoM.Translate(float64(x), float64(y))
+	screen.DrawImage(textImage, op)
+}
+
+// outlinedText returns s rendered with a 1px outline into a w by h image.
+// ascent is how far the text rises above its baseline
+func outlinedText(s string, w, h, ascent int, textColor, outlineColor color.Color) *ebiten.Image {
+	key := textKey{text: s, face: DefaultFont, color: toRGBA(textColor), outline: toRGBA(outlineColor)}
+	if textImage, ok := renderedText.get(key); ok {
+		return textImage
+	}
+	
 	textImage := ebiten.NewImage(w, h)
 	
 	// Calculate the base position, adjusted for the outline
 	baseX := 1 // Add 1px for outline
-	baseY := -bounds.Min.Y + 1 // Adjust position and add 1px for outline
+	baseY := ascent + 1 // Adjust position and add 1px for outline
 	
-	// Draw the outline by drawing the text multiple times at offset positions
-	// Top-left
-	text.Draw(textImage, s, DefaultFont, baseX-1, baseY-1, outlineColor)
-	// Top
-	text.Draw(textImage, s, DefaultFont, baseX, baseY-1, outlineColor)
-	// Top-right
-	text.Draw(textImage, s, DefaultFont, baseX+1, baseY-1, outlineColor)
-	// Left
-	text.Draw(textImage, s, DefaultFont, baseX-1, baseY, outlineColor)
-	// Right
-	text.Draw(textImage, s, DefaultFont, baseX+1, baseY, outlineColor)
-	// Bottom-left
-	text.Draw(textImage, s, DefaultFont, baseX-1, baseY+1, outlineColor)
-	// Bottom
-	text.Draw(textImage, s, DefaultFont, baseX, baseY+1, outlineColor)
-	// Bottom-right
-	text.Draw(textImage, s, DefaultFont, baseX+1, baseY+1, outlineColor)
+	// Draw the outline by drawing the text at each neighbouring offset
+	for dy := -1; dy <= 1; dy++ {
+		for dx := -1; dx <= 1; dx++ {
+			if dx != 0 || dy != 0 {
+				text.Draw(textImage, s, DefaultFont, baseX+dx, baseY+dy, outlineColor)
+			}
+		}
+	}
 	
 	// Draw the main text in the center
 	text.Draw(textImage, s, DefaultFont, baseX, baseY, textColor)
 	
-	// Draw the scaled text to the screen
-	op := &ebiten.DrawImageOptions{}
-	op.GeoM.Scale(FontScale, FontScale)
-	op.GeoM.Translate(float64(x), float64(y))
-	screen.DrawImage(textImage, op)
+	renderedText.put(key, textImage)
+	return textImage
 }
 
 // DrawTextColor draws text with a color but no outline
-func DrawTextColor(screen *ebiten.Image, s string, x, y int, clr color.Color) {
+func (r *Renderer) DrawTextColor(screen *ebiten.Image, s string, x, y int, clr color.Color) {
 	// Now just calls DrawTextWithOutline with outline color set to transparent
 	transparent := color.RGBA{0, 0, 0, 0}
-	DrawTextWithOutline(screen, s, x, y, clr, transparent)
+	DrawTextWithOutline(screen, s, x, y, clr, transparent, r.HighContrast)
 }
 
 // DrawText draws text with the default color and a black outline
-func DrawText(screen *ebiten.Image, s string, x, y int) {
-	DrawTextWithOutline(screen, s, x, y, DefaultTextColor, OutlineColor)
+func (r *Renderer) DrawText(screen *ebiten.Image, s string, x, y int) {
+	DrawTextWithOutline(screen, s, x, y, DefaultTextColor, OutlineColor, r.HighContrast)
+}
+
+// TextWidth returns the on-screen width of s in pixels, outline included
+func TextWidth(s string) int {
+	if s == "" {
+		return 0
+	}
+	return int(float64(text.BoundString(DefaultFont, s).Dx()+2) * FontScale)
+}
+
+// CenteredX returns the x at which s has to start to be centered on centerX
+func CenteredX(s string, centerX int) int {
+	return centerX - TextWidth(s)/2
+}
+
+// RightAlignedX returns the x at which s has to start to end at rightX
+func RightAlignedX(s string, rightX int) int {
+	return rightX - TextWidth(s)
+}
+
+// DrawTextCentered draws text horizontally centered on centerX
+func (r *Renderer) DrawTextCentered(screen *ebiten.Image, s string, centerX, y int) {
+	r.DrawText(screen, s, CenteredX(s, centerX), y)
+}
+
+// DrawTextRight draws text so that it ends at rightX
+func (r *Renderer) DrawTextRight(screen *ebiten.Image, s string, rightX, y int) {
+	r.DrawText(screen, s, RightAlignedX(s, rightX), y)
 }
 
 // Helper function for Go versions earlier than 1.21 which might not have max in the standard library
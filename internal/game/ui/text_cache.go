@@ -0,0 +1,88 @@
+// internal/game/ui/text_cache.go
+package ui
+
+import (
+	"container/list"
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"golang.org/x/image/font"
+)
+
+// TextCacheCapacity is how many rendered strings are kept before the least
+// recently drawn one is thrown away
+const TextCacheCapacity = 256
+
+// textKey identifies a rendered string: the same text in the same colors
+// and font always rasterizes to the same image
+type textKey struct {
+	text    string
+	face    font.Face
+	color   color.RGBA
+	outline color.RGBA
+}
+
+// textEntry is a cached image together with the key it was stored under
+type textEntry struct {
+	key   textKey
+	image *ebiten.Image
+}
+
+// textCache keeps recently drawn strings pre-rendered so labels drawn every
+// frame, like the turn text and menu items, are only rasterized once
+type textCache struct {
+	capacity int
+	entries  map[textKey]*list.Element
+	order    *list.List // Most recently used at the front
+}
+
+// newTextCache creates an empty cache holding at most capacity images
+func newTextCache(capacity int) *textCache {
+	return &textCache{
+		capacity: capacity,
+		entries:  make(map[textKey]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// get returns the image stored for key, marking it as recently used
+func (c *textCache) get(key textKey) (*ebiten.Image, bool) {
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(element)
+	return element.Value.(*textEntry).image, true
+}
+
+// put stores an image for key, evicting the least recently used images
+// once the cache is over capacity
+func (c *textCache) put(key textKey, image *ebiten.Image) {
+	if element, ok := c.entries[key]; ok {
+		element.Value.(*textEntry).image = image
+		c.order.MoveToFront(element)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&textEntry{key: key, image: image})
+	for c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		entry := oldest.Value.(*textEntry)
+		c.order.Remove(oldest)
+		delete(c.entries, entry.key)
+		entry.image.Deallocate()
+	}
+}
+
+// Len returns how many images are cached
+func (c *textCache) Len() int {
+	return c.order.Len()
+}
+
+// renderedText holds the images of strings drawn by DrawTextWithOutline
+var renderedText = newTextCache(TextCacheCapacity)
+
+// toRGBA converts any color to a comparable RGBA for use in a cache key
+func toRGBA(c color.Color) color.RGBA {
+	return color.RGBAModel.Convert(c).(color.RGBA)
+}
@@ -0,0 +1,85 @@
+// internal/game/ui/text_cache_test.go
+package ui
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+func TestRepeatedTextReusesItsImage(t *testing.T) {
+	white, black := color.RGBA{255, 255, 255, 255}, color.RGBA{0, 0, 0, 255}
+	first := outlinedText("Turn 7", 60, 20, 14, white, black)
+	if again := outlinedText("Turn 7", 60, 20, 14, white, black); again != first {
+		t.Error("drawing the same string again rasterized a new image")
+	}
+	if other := outlinedText("Turn 7", 60, 20, 14, black, white); other == first {
+		t.Error("the same string in other colors reused the image")
+	}
+}
+
+func TestTextCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	c := newTextCache(2)
+	keys := []textKey{{text: "a"}, {text: "b"}, {text: "c"}}
+	images := []*ebiten.Image{ebiten.NewImage(1, 1), ebiten.NewImage(1, 1), ebiten.NewImage(1, 1)}
+
+	c.put(keys[0], images[0])
+	c.put(keys[1], images[1])
+	if got, ok := c.get(keys[0]); !ok || got != images[0] {
+		t.Fatal("a cached image wasn't returned")
+	}
+	c.put(keys[2], images[2])
+
+	if c.Len() != 2 {
+		t.Errorf("Len() = %d, want the capacity 2", c.Len())
+	}
+	if _, ok := c.get(keys[1]); ok {
+		t.Error("b was least recently used and should have been evicted")
+	}
+	for _, i := range []int{0, 2} {
+		if got, ok := c.get(keys[i]); !ok || got != images[i] {
+			t.Errorf("%q was evicted, want it kept", keys[i].text)
+		}
+	}
+}
+
+// drawLabels draws two labels in turn, as the HUD does every frame, with
+// renderedText swapped for a cache of the given capacity. A capacity of 1
+// keeps evicting the other label, so every draw rasterizes again
+func drawLabels(capacity, draws int) func() {
+	screen := ebiten.NewImage(200, 50)
+	white, black := color.RGBA{255, 255, 255, 255}, color.RGBA{0, 0, 0, 255}
+	labels := []string{"Turn 7", "Score 12"}
+	return func() {
+		saved := renderedText
+		renderedText = newTextCache(capacity)
+		defer func() { renderedText = saved }()
+		for i := 0; i < draws; i++ {
+			DrawTextWithOutline(screen, labels[i%len(labels)], 10, 10, white, black, false)
+		}
+	}
+}
+
+func TestTextCacheReducesAllocations(t *testing.T) {
+	cached := testing.AllocsPerRun(10, drawLabels(TextCacheCapacity, 20))
+	uncached := testing.AllocsPerRun(10, drawLabels(1, 20))
+	if cached >= uncached {
+		t.Errorf("%v allocations with the cache, %v without, want fewer with it", cached, uncached)
+	}
+}
+
+func BenchmarkDrawTextWithOutline(b *testing.B) {
+	for _, bench := range []struct {
+		name     string
+		capacity int
+	}{
+		{"cached", TextCacheCapacity},
+		{"uncached", 1},
+	} {
+		b.Run(bench.name, func(b *testing.B) {
+			b.ReportAllocs()
+			drawLabels(bench.capacity, b.N)()
+		})
+	}
+}
@@ -0,0 +1,66 @@
+// internal/game/ui/text_test.go
+package ui
+
+import (
+	"image/color"
+	"testing"
+)
+
+func TestHighContrastChangesTextStyle(t *testing.T) {
+	textColor := color.RGBA{200, 0, 0, 255}
+
+	normal := ResolveTextStyle(textColor, OutlineColor, false)
+	if normal.Text != textColor || normal.Outline != OutlineColor || normal.Background != nil {
+		t.Errorf("normal style = %+v, want the requested colors and no background", normal)
+	}
+
+	contrast := ResolveTextStyle(textColor, OutlineColor, true)
+	if contrast.Text != HighContrastTextColor {
+		t.Errorf("high contrast text = %v, want %v", contrast.Text, HighContrastTextColor)
+	}
+	if contrast.Background != HighContrastBackground {
+		t.Errorf("high contrast background = %v, want %v", contrast.Background, HighContrastBackground)
+	}
+	if _, _, _, a := contrast.Outline.RGBA(); a != 0 {
+		t.Error("high contrast text shouldn't be outlined")
+	}
+}
+
+func TestTurnBannerKeepsRendererContrast(t *testing.T) {
+	r := NewRenderer()
+	if r.NewTurnBanner("Your turn").HighContrast {
+		t.Error("banner uses high contrast while the renderer doesn't")
+	}
+
+	r.HighContrast = true
+	if !r.NewTurnBanner("Your turn").HighContrast {
+		t.Error("banner ignores the renderer's high contrast mode")
+	}
+}
+
+func TestCenteredXLeavesEqualMargins(t *testing.T) {
+	const width = 400
+	for _, s := range []string{"Mazenasium", "Your turn", "W"} {
+		w := TextWidth(s)
+		if w <= 0 {
+			t.Fatalf("TextWidth(%q) = %d", s, w)
+		}
+
+		x := CenteredX(s, width/2)
+		left, right := x, width-(x+w)
+		if left-right > 1 || right-left > 1 {
+			t.Errorf("%q centered at x=%d: margins %d and %d", s, x, left, right)
+		}
+	}
+
+	if x := CenteredX("", width/2); x != width/2 {
+		t.Errorf("empty text centered at x=%d, want %d", x, width/2)
+	}
+}
+
+func TestRightAlignedXEndsAtTheEdge(t *testing.T) {
+	s := "Score: 12"
+	if x := RightAlignedX(s, 300); x+TextWidth(s) != 300 {
+		t.Errorf("%q right-aligned at x=%d ends at %d, want 300", s, x, x+TextWidth(s))
+	}
+}
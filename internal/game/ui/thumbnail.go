@@ -0,0 +1,77 @@
+// internal/game/ui/thumbnail.go
+package ui
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+
+	"github.com/JacobCromwell/Mazenasium/internal/game/maze"
+)
+
+// ThumbnailSize is the width and height of the menu's maze preview, in pixels
+const ThumbnailSize = 160
+
+// ThumbnailArea returns where the maze preview sits on the menu: the top
+// right corner of the menu box
+func ThumbnailArea() Rect {
+	return Rect{
+		X:      ScreenWidth - 100 - 20 - ThumbnailSize,
+		Y:      140,
+		Width:  ThumbnailSize,
+		Height: ThumbnailSize,
+	}
+}
+
+// mazeThumbnail returns a scaled-down picture of the maze that fits
+// ThumbnailArea. It is only redrawn when a different maze is passed in,
+// which happens whenever a new seed or setting regenerates the maze
+func (r *Renderer) mazeThumbnail(mazeObj *maze.Maze) *ebiten.Image {
+	if r.thumbnail != nil && r.thumbnailOf == mazeObj {
+		return r.thumbnail
+	}
+
+	// Draw the maze at full size, then scale it down the same way a maze
+	// too big for the maze section is fitted
+	board := r.boardTarget(mazeObj)
+	DrawMaze(board, mazeObj, 0, 0, MazeDrawOptions{})
+	tileSize := mazeObj.GetTileSize()
+	start := mazeObj.StartPosition()
+	ebitenutil.DrawRect(board, float64(start.X)*tileSize, float64(start.Y)*tileSize, tileSize, tileSize, color.RGBA{0, 200, 0, 255})
+
+	mazeWidth, mazeHeight := mazeObj.PixelSize()
+	scale := FitScale(mazeWidth, mazeHeight, ThumbnailArea())
+	w, h := int(math.Ceil(mazeWidth*scale)), int(math.Ceil(mazeHeight*scale))
+	if r.thumbnail == nil || r.thumbnail.Bounds().Dx() != w || r.thumbnail.Bounds().Dy() != h {
+		r.thumbnail = ebiten.NewImage(w, h)
+	}
+	r.thumbnail.Clear()
+
+	op := &ebiten.DrawImageOptions{Filter: ebiten.FilterLinear}
+	op.GeoM.Scale(scale, scale)
+	r.thumbnail.DrawImage(board, op)
+	r.thumbnailOf = mazeObj
+	return r.thumbnail
+}
+
+// drawMazeThumbnail shows the maze the next match will be played on,
+// centered in ThumbnailArea with a frame around it
+func (r *Renderer) drawMazeThumbnail(screen *ebiten.Image, mazeObj *maze.Maze) {
+	if mazeObj == nil {
+		return
+	}
+	area := ThumbnailArea()
+	ebitenutil.DrawRect(screen, float64(area.X-2), float64(area.Y-2), float64(area.Width+4), float64(area.Height+4), color.RGBA{20, 20, 30, 255})
+
+	thumbnail := r.mazeThumbnail(mazeObj)
+	bounds := thumbnail.Bounds()
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(
+		float64(area.X)+float64(area.Width-bounds.Dx())/2,
+		float64(area.Y)+float64(area.Height-bounds.Dy())/2,
+	)
+	screen.DrawImage(thumbnail, op)
+	r.DrawText(screen, "Next maze", area.X, area.Y+area.Height+20)
+}
@@ -4,11 +4,14 @@ import (
 	"fmt"
 	"image/color"
 	"strings"
+	"time"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
 
 	"github.com/JacobCromwell/Mazenasium/internal/game/action"
+	"github.com/JacobCromwell/Mazenasium/internal/game/animation"
+	"github.com/JacobCromwell/Mazenasium/internal/game/eventlog"
 	"github.com/JacobCromwell/Mazenasium/internal/game/maze"
 	"github.com/JacobCromwell/Mazenasium/internal/game/npc"
 	"github.com/JacobCromwell/Mazenasium/internal/game/player"
@@ -18,15 +21,92 @@ import (
 	"github.com/JacobCromwell/Mazenasium/internal/game/menu"
 )
 
+// Default, smallest and largest screen sizes in pixels
 const (
-	ScreenWidth  = 1200
-	ScreenHeight = 1000
+	DefaultScreenWidth  = 1200
+	DefaultScreenHeight = 1000
+	MinScreenWidth      = 800
+	MinScreenHeight     = 600
+	MaxScreenWidth      = 3840
+	MaxScreenHeight     = 2160
 )
 
+// ScreenWidth and ScreenHeight are the current screen size, set with SetScreenSize
+var (
+	ScreenWidth  = DefaultScreenWidth
+	ScreenHeight = DefaultScreenHeight
+)
+
+// ClampScreenSize keeps a screen size between the smallest and largest supported
+func ClampScreenSize(width, height int) (int, int) {
+	return min(max(width, MinScreenWidth), MaxScreenWidth), min(max(height, MinScreenHeight), MaxScreenHeight)
+}
+
+// SetScreenSize changes the size everything is laid out for, within the supported range
+func SetScreenSize(width, height int) {
+	ScreenWidth, ScreenHeight = ClampScreenSize(width, height)
+}
+
+// BackgroundColor is the fill color behind every screen
+var BackgroundColor = color.RGBA{40, 45, 55, 255}
+
+// WinReason is how a match ended, which picks the game over message
+type WinReason int
+
+const (
+	ReachedGoal  WinReason = iota // The winner got to the goal first
+	CaughtPlayer                  // An NPC landed on a human's cell while chasing
+	WalledIn                      // The shrinking maze cut the player off from the goal
+	Survived                      // The player lasted the survival rounds
+	TargetScore                   // The player's trivia points reached the target
+)
+
+// GameOverInfo is the summary shown on the game over screen
+type GameOverInfo struct {
+	Winner     string
+	Reason     WinReason
+	NewRecord  bool   // The player's run beat the stored best for this maze size
+	BestRecord string // Formatted best run for this maze size, empty if none
+
+	// Match statistics for the results panel
+	Turns         int
+	PlayerMoves   int
+	ActionsUsed   []string // One "Name: count" line per action
+	TriviaCorrect int
+	TriviaTotal   int
+	TriviaRecap   []string // One line per answered question, oldest first
+	Score         int
+	Elapsed       time.Duration
+	Route         []maze.Position // Cells the player took, drawn as a trail behind the panel
+}
+
 // Renderer handles all UI rendering for the game
 type Renderer struct {
 	actionMsg   string
-	actionTimer int
+	actionTimer float64         // Seconds left before the action message clears, 0 for no timeout
+	actionKind  MessageKind     // Styling of the action message
+	msgQueue    []queuedMessage // Messages waiting for the current one to time out
+
+	MazeOptions       MazeDrawOptions // Debug rendering modes for the maze
+	ExploredPercent   float64         // Share of the maze the player has visited, 0-100
+	Shake             *ScreenShake    // Screen shake feedback for traps and penalties
+	HidePlayer        bool            // Spectating NPCs, don't draw the player
+	Partner           *player.Player  // Co-op teammate waiting for their turn, nil outside co-op
+	Score             int             // Player's current points
+	MovesLeft         int             // Steps the player has left this turn
+	MoveBudget        int             // Steps the player gets per turn, the counter shows above 1
+	SurvivalTurnsLeft int             // Rounds left to survive, shown while above 0
+	TargetScore       int             // Points that win the match, shown next to the score while above 0
+	PopupAnchor       PopupAnchor     // Where the action popup is placed
+	GoalPulse         *GoalPulse      // Glow animation around the goal tile
+	FitMaze           bool            // Scale a maze too big for its section down to fit
+	HighContrast      bool            // Draw all text white on a solid dark box
+
+	shakeBuffer *ebiten.Image // Offscreen frame used while shaking
+	boardBuffer *ebiten.Image // Offscreen maze drawn at full size before scaling to fit
+	thumbnail   *ebiten.Image // Scaled-down preview of the next maze for the menu
+	thumbnailOf *maze.Maze    // Maze the thumbnail was drawn from
+	layers      RenderQueue   // Draw calls of the frame being built, flushed in layer order
 }
 
 // NewRenderer creates a new UI renderer
@@ -34,27 +114,62 @@ func NewRenderer() *Renderer {
 	return &Renderer{
 		actionMsg:   "",
 		actionTimer: 0,
+		Shake:       NewScreenShake(),
+		GoalPulse:   NewGoalPulse(),
 	}
 }
 
-// SetActionMessage sets a temporary action message to display
+// SetActionMessage sets a temporary info message to display
+// duration is in frames at TicksPerSecond, 0 keeps the message until replaced
 func (r *Renderer) SetActionMessage(msg string, duration int) {
-	r.actionMsg = msg
-	r.actionTimer = duration
+	r.ShowMessage(msg, FramesToSeconds(duration), InfoMessage)
+}
+
+// ToggleHeatmap switches the visit heatmap debug view on or off
+func (r *Renderer) ToggleHeatmap() {
+	r.MazeOptions.Heatmap = !r.MazeOptions.Heatmap
 }
 
-// UpdateActionTimer updates the action message timer
-func (r *Renderer) UpdateActionTimer() {
+// ToggleCoordinates switches the tile coordinates debug overlay on or off
+func (r *Renderer) ToggleCoordinates() {
+	r.MazeOptions.Coordinates = !r.MazeOptions.Coordinates
+}
+
+// ToggleReveal switches the reveal-the-maze cheat view on or off
+func (r *Renderer) ToggleReveal() {
+	r.MazeOptions.Reveal = !r.MazeOptions.Reveal
+}
+
+// mazeDrawOptions returns the maze rendering modes with the current goal glow
+// and the light centered on the player
+func (r *Renderer) mazeDrawOptions(playerObj *player.Player) MazeDrawOptions {
+	opts := r.MazeOptions
+	opts.GoalGlow = r.GoalPulse.Glow()
+
+	// The light follows the player as they slide, and there's nobody to
+	// light the way while spectating
+	if r.HidePlayer || playerObj == nil || playerObj.TileSize <= 0 {
+		opts.LightRadius = 0
+	} else {
+		opts.LightX = playerObj.X/playerObj.TileSize + 0.5
+		opts.LightY = playerObj.Y/playerObj.TileSize + 0.5
+	}
+	return opts
+}
+
+// UpdateActionTimer counts the action message timer down by dt seconds,
+// moving on to the next queued message when it runs out
+func (r *Renderer) UpdateActionTimer(dt float64) {
 	if r.actionTimer > 0 {
-		r.actionTimer--
-		if r.actionTimer == 0 {
-			r.actionMsg = ""
+		r.actionTimer -= dt
+		if r.actionTimer <= 0 {
+			r.nextMessage()
 		}
 	}
 }
 
 // Add this method to the Renderer struct
-func (r *Renderer) drawMenu(screen *ebiten.Image, menuManager *menu.Manager) {
+func (r *Renderer) drawMenu(screen *ebiten.Image, menuManager *menu.Manager, mazeObj *maze.Maze) {
     if menuManager == nil || menuManager.CurrentMenu == nil {
         return
     }
@@ -62,16 +177,21 @@ func (r *Renderer) drawMenu(screen *ebiten.Image, menuManager *menu.Manager) {
     currentMenu := menuManager.CurrentMenu
     
     // Draw menu background
-    ebitenutil.DrawRect(screen, 100, 100, ScreenWidth-200, ScreenHeight-200, color.RGBA{50, 50, 80, 240})
+    ebitenutil.DrawRect(screen, 100, 100, float64(ScreenWidth-200), float64(ScreenHeight-200), color.RGBA{50, 50, 80, 240})
     
     // Draw menu title
     titleX := ScreenWidth/2 - len(currentMenu.Title)*4
-    DrawText(screen, currentMenu.Title, titleX, 120)
+    r.DrawText(screen, currentMenu.Title, titleX, 120)
     
-    // Draw menu items
-    for i, item := range currentMenu.Items {
-        itemY := 160 + (i * 40)
-        itemText := item.Text
+    // Draw menu items, closing up the gaps left by hidden ones
+    row := 0
+    for _, item := range currentMenu.Items {
+        if item.Hidden {
+            continue
+        }
+        itemY := 160 + (row * 40)
+        row++
+        itemText := item.Label()
         
         // Add indicator for submenu
         if item.Type == menu.SubmenuItem {
@@ -80,14 +200,17 @@ func (r *Renderer) drawMenu(screen *ebiten.Image, menuManager *menu.Manager) {
         
         // Draw selection indicator for selected item
         if item.Selected {
-            DrawText(screen, "> " + itemText, ScreenWidth/2 - 100, itemY)
+            r.DrawText(screen, "> " + itemText, ScreenWidth/2 - 100, itemY)
         } else {
-            DrawText(screen, "  " + itemText, ScreenWidth/2 - 100, itemY)
+            r.DrawText(screen, "  " + itemText, ScreenWidth/2 - 100, itemY)
         }
     }
     
+    // Preview the maze so players can reroll before starting
+    r.drawMazeThumbnail(screen, mazeObj)
+    
     // Draw instructions
-    DrawText(screen, "↑/↓: Navigate, Enter: Select", ScreenWidth/2 - 120, ScreenHeight - 150)
+    r.DrawText(screen, "↑/↓: Navigate, Enter: Select", ScreenWidth/2 - 120, ScreenHeight - 150)
 }
 
 // Update the Draw method to include the menu state
@@ -102,21 +225,57 @@ func (r *Renderer) Draw(
     actionManager *action.Manager,
     menuManager *menu.Manager,
     flavorManager *flavor.Manager, // Add flavor manager
-    winner string,
+    animationManager *animation.Manager,
+    eventLog *eventlog.Log,
+    gameOver GameOverInfo,
 ) {
+    // While shaking, draw the frame offscreen so it can be offset as a whole
+    target := screen
+    if r.Shake.IsActive() {
+        target = r.shakeTarget(screen)
+    }
+
     // Draw background
-    screen.Fill(color.RGBA{40, 45, 55, 255})
+    target.Fill(BackgroundColor)
 
     switch gameState {
     case 0: // Menu
-        r.drawMenu(screen, menuManager)
+        r.drawMenu(target, menuManager, mazeObj)
+        
+        // Settings problems, like a bad seed, are reported at the bottom
+        if r.actionMsg != "" {
+            r.drawActionMessage(target)
+        }
     case 1: // Playing
-        r.drawPlayingSplitScreen(screen, mazeObj, playerObj, npcManager, turnManager, actionManager, flavorManager)
+        r.drawPlayingSplitScreen(target, mazeObj, playerObj, npcManager, turnManager, actionManager, flavorManager, eventLog)
     case 2: // AnsweringTrivia
-        r.drawTrivia(screen, triviaManager)
+        r.drawTrivia(target, triviaManager)
     case 3: // GameOver
-        r.drawGameOver(screen, winner)
+        r.drawGameOver(target, mazeObj, gameOver)
+    }
+
+    // Draw animations on top of everything else
+    if animationManager != nil {
+        animationManager.Draw(target)
     }
+
+    // Blit the shaken frame onto the screen
+    if target != screen {
+        screen.Fill(BackgroundColor)
+        op := &ebiten.DrawImageOptions{}
+        op.GeoM.Translate(r.Shake.Offset())
+        screen.DrawImage(target, op)
+    }
+}
+
+// shakeTarget returns an offscreen buffer matching the screen size
+func (r *Renderer) shakeTarget(screen *ebiten.Image) *ebiten.Image {
+    bounds := screen.Bounds()
+    if r.shakeBuffer == nil || r.shakeBuffer.Bounds() != bounds {
+        r.shakeBuffer = ebiten.NewImage(bounds.Dx(), bounds.Dy())
+    }
+    r.shakeBuffer.Clear()
+    return r.shakeBuffer
 }
 
 // Add a new method for split-screen rendering
@@ -128,144 +287,384 @@ func (r *Renderer) drawPlayingSplitScreen(
     turnManager *turn.Manager,
     actionManager *action.Manager,
     flavorManager *flavor.Manager,
+    eventLog *eventlog.Log,
 ) {
     // Create a layout manager
     layout := NewLayoutManager(ScreenWidth, ScreenHeight)
     
     // Get the maze section
     mazeSection := layout.GetSection(MazeSection)
+    layers := &r.layers
     
-    // Draw maze section border
-    if mazeSection.Border {
-        // Draw section border
-        borderColor := color.RGBA{70, 70, 100, 255}
-        ebitenutil.DrawRect(
-            screen,
-            float64(mazeSection.Rect.X),
-            float64(mazeSection.Rect.Y),
-            float64(mazeSection.Rect.Width),
-            float64(mazeSection.Rect.Height),
-            borderColor,
-        )
-    }
-    
-    // Draw section title
-    if mazeSection.Title != "" {
-        DrawText(screen, mazeSection.Title, mazeSection.Rect.X + 10, mazeSection.Rect.Y + 20)
-    }
+    // Draw maze section border and title
+    layers.Add(FrameLayer, func(screen *ebiten.Image) {
+        r.drawSectionFrame(screen, mazeSection)
+    })
     
     // Draw the maze with proper offset to center it in the section
-    // Account for title height
-    mazeOffsetY := float64(mazeSection.Rect.Y) + 40 // Add space for title
-    
-    // Calculate maze width and height in pixels
-    mazeWidthPixels := float64(mazeObj.State.Width * int(maze.TileSize))
-    //mazeHeightPixels := float64(mazeObj.State.Height * int(maze.TileSize))
+    mazeX, mazeY, mazeScale := MazeView(mazeObj, r.FitMaze)
+    mazeOffsetX, mazeOffsetY := mazeX, mazeY
+    _, mazeHeightPixels := mazeObj.PixelSize()
     
-    // Center the maze in the section
-    mazeOffsetX := float64(mazeSection.Rect.X) + (float64(mazeSection.Rect.Width) - mazeWidthPixels) / 2
+    // A maze scaled to fit is drawn at full size offscreen, with everything
+    // on it, then blitted down in one go so overlays stay lined up
+    board := func(screen *ebiten.Image) *ebiten.Image { return screen }
+    if mazeScale < 1 {
+        buffer := r.boardTarget(mazeObj)
+        mazeOffsetX, mazeOffsetY = 0, 0
+        board = func(*ebiten.Image) *ebiten.Image { return buffer }
+    }
     
     // Draw the maze
-    DrawMaze(screen, mazeObj, mazeOffsetX, mazeOffsetY)
+    mazeOptions := r.mazeDrawOptions(playerObj)
+    layers.Add(MazeLayer, func(screen *ebiten.Image) {
+        DrawMaze(board(screen), mazeObj, mazeOffsetX, mazeOffsetY, mazeOptions)
+    })
     
     // Draw NPCs
-    for _, npc := range npcManager.NPCs {
-        ebitenutil.DrawRect(
-            screen, 
-            mazeOffsetX + npc.X + 1, 
-            mazeOffsetY + npc.Y + 1, 
-            npc.Size, 
-            npc.Size, 
-            npc.Color,
-        )
+    layers.Add(EntityLayer, func(screen *ebiten.Image) {
+        for _, npc := range npcManager.NPCs {
+            DrawShape(
+                board(screen), 
+                npc.Shape,
+                mazeOffsetX + npc.X + 1, 
+                mazeOffsetY + npc.Y + 1, 
+                npc.Size, 
+                npc.Color,
+            )
+        }
+    })
+    
+    // Outline the NPC whose turn it is
+    if actingID, ok := npcManager.ActingID(); ok && !turnManager.IsPlayerTurn() {
+        layers.Add(EntityLayer, func(screen *ebiten.Image) {
+            for _, npc := range npcManager.NPCs {
+                if npc.ID == actingID {
+                    drawOutline(board(screen), mazeOffsetX+npc.X+1, mazeOffsetY+npc.Y+1, npc.Size, color.RGBA{255, 255, 255, 255})
+                }
+            }
+            r.DrawText(screen, fmt.Sprintf("NPC %d moving...", actingID+1), mazeSection.Rect.X+mazeSection.Rect.Width-150, mazeSection.Rect.Y+20)
+        })
+    }
+    
+    // Draw the waiting co-op teammate under the player whose turn it is
+    if partner := r.Partner; partner != nil && !r.HidePlayer {
+        partnerX, partnerY := partner.GetPosition()
+        layers.Add(PlayerLayer, func(screen *ebiten.Image) {
+            ebitenutil.DrawRect(
+                board(screen),
+                mazeOffsetX + partnerX + 1,
+                mazeOffsetY + partnerY + 1,
+                partner.Size,
+                partner.Size,
+                color.RGBA{0, 160, 255, 180},
+            )
+        })
     }
     
     // Draw player
     playerX, playerY := playerObj.GetPosition()
-    ebitenutil.DrawRect(
-        screen, 
-        mazeOffsetX + playerX + 1, 
-        mazeOffsetY + playerY + 1, 
-        playerObj.Size, 
-        playerObj.Size, 
-        color.RGBA{0, 0, 255, 255},
-    )
+    if !r.HidePlayer {
+        layers.Add(PlayerLayer, func(screen *ebiten.Image) {
+            ebitenutil.DrawRect(
+                board(screen), 
+                mazeOffsetX + playerX + 1, 
+                mazeOffsetY + playerY + 1, 
+                playerObj.Size, 
+                playerObj.Size, 
+                color.RGBA{0, 0, 255, 255},
+            )
+        })
+    }
     
-    // Get the flavor section
-    flavorSection := layout.GetSection(FlavorSection)
+    // Point toward the goal while it is being peeked at
+    if r.MazeOptions.PeekGoal {
+        layers.Add(OverlayLayer, func(screen *ebiten.Image) {
+            drawGoalPointer(board(screen), mazeObj, playerObj, mazeOffsetX, mazeOffsetY)
+        })
+    }
     
-    // Draw flavor section border
-    if flavorSection.Border {
-        // Draw section border
-        borderColor := color.RGBA{70, 70, 100, 255}
-        ebitenutil.DrawRect(
-            screen,
-            float64(flavorSection.Rect.X),
-            float64(flavorSection.Rect.Y),
-            float64(flavorSection.Rect.Width),
-            float64(flavorSection.Rect.Height),
-            borderColor,
-        )
+    // Point toward the goal from the edge of the section when it's out of view
+    if !r.HidePlayer {
+        layers.Add(HUDLayer, func(screen *ebiten.Image) {
+            r.drawGoalCompass(screen, mazeObj, playerObj, mazeSection, mazeX, mazeY, mazeScale)
+        })
     }
     
-    // Draw section title
-    if flavorSection.Title != "" {
-        DrawText(screen, flavorSection.Title, flavorSection.Rect.X + 10, flavorSection.Rect.Y + 20)
+    // Point toward the closest part of the maze not yet explored
+    if target := r.MazeOptions.Unexplored; target != nil && !r.HidePlayer {
+        layers.Add(OverlayLayer, func(screen *ebiten.Image) {
+            drawPointer(board(screen), mazeObj, playerObj, *target, color.RGBA{120, 200, 255, 255}, mazeOffsetX, mazeOffsetY)
+        })
     }
     
-    // Draw flavor image if available
-    if flavorManager != nil && flavorManager.CurrentImage != nil {
-        // Draw the flavor image in its section
-        flavorManager.Draw(
-            screen,
-            flavorSection.Rect.X,
-            flavorSection.Rect.Y + 40, // Add space for title
-            flavorSection.Rect.Width,
-            flavorSection.Rect.Height - 40,
-        )
-    } else {
-        // Draw a placeholder message
-        DrawText(
-            screen,
-            "No flavor image available",
-            flavorSection.Rect.X + 50,
-            flavorSection.Rect.Y + 100,
-        )
+    // The maze is always drawn in full, so revealing adds the way out
+    if r.MazeOptions.Reveal && !r.HidePlayer {
+        layers.Add(OverlayLayer, func(screen *ebiten.Image) {
+            drawSolutionPath(board(screen), mazeObj, playerObj, mazeOffsetX, mazeOffsetY)
+        })
     }
     
-    // Draw UI info in the maze section
-    // Display near the top of the maze section
-    DrawText(screen, turnManager.OwnerText(), mazeSection.Rect.X + 10, mazeSection.Rect.Y + 60)
-    DrawText(screen, turnManager.StateText(), mazeSection.Rect.X + 10, mazeSection.Rect.Y + 80)
+    // Shrink the finished board onto the screen after everything drawn on it
+    if mazeScale < 1 {
+        layers.Add(OverlayLayer, func(screen *ebiten.Image) {
+            op := &ebiten.DrawImageOptions{}
+            op.GeoM.Scale(mazeScale, mazeScale)
+            op.GeoM.Translate(mazeX, mazeY)
+            op.Filter = ebiten.FilterLinear
+            screen.DrawImage(board(screen), op)
+        })
+    }
+    
+    // Get the flavor section
+    flavorSection := layout.GetSection(FlavorSection)
+    
+    layers.Add(PanelLayer, func(screen *ebiten.Image) {
+        // Draw flavor section border and title
+        r.drawSectionFrame(screen, flavorSection)
+        
+        // Draw flavor image if available
+        if flavorManager != nil && flavorManager.CurrentImage != nil {
+            // Draw the flavor image in its section
+            flavorManager.Draw(
+                screen,
+                flavorSection.Rect.X,
+                flavorSection.Rect.Y + 40, // Add space for title
+                flavorSection.Rect.Width,
+                flavorSection.Rect.Height - 40,
+            )
+        } else {
+            // Draw a placeholder message
+            r.DrawText(
+                screen,
+                "No flavor image available",
+                flavorSection.Rect.X + 50,
+                flavorSection.Rect.Y + 100,
+            )
+        }
+    })
+    
+    // Draw the exploration meter and event log in the empty space below the maze
+    belowMazeY := int(mazeY + mazeHeightPixels*mazeScale) + 20
+    layers.Add(HUDLayer, func(screen *ebiten.Image) {
+        // Draw UI info in the maze section
+        // Display near the top of the maze section
+        r.DrawText(screen, turnManager.OwnerText(), mazeSection.Rect.X + 10, mazeSection.Rect.Y + 60)
+        r.DrawText(screen, turnManager.StateText(), mazeSection.Rect.X + 10, mazeSection.Rect.Y + 80)
+        if r.MoveBudget > 1 && turnManager.IsPlayerTurn() && turnManager.CurrentState == turn.WaitingForMove {
+            r.DrawText(screen, fmt.Sprintf("Moves left: %d/%d (Enter: done)", r.MovesLeft, r.MoveBudget), mazeSection.Rect.X + 10, mazeSection.Rect.Y + 100)
+        }
+        
+        if r.SurvivalTurnsLeft > 0 {
+            r.DrawText(screen, fmt.Sprintf("Survive %d more turns", r.SurvivalTurnsLeft), mazeSection.Rect.X + mazeSection.Rect.Width - 220, mazeSection.Rect.Y + 60)
+        }
+        scoreText := fmt.Sprintf("Score: %d", r.Score)
+        if r.TargetScore > 0 {
+            scoreText = fmt.Sprintf("Score: %d/%d", r.Score, r.TargetScore)
+        }
+        r.DrawText(screen, fmt.Sprintf("Explored: %.0f%%   %s", r.ExploredPercent, scoreText), mazeSection.Rect.X + 10, belowMazeY)
+        r.drawEventLog(screen, eventLog, mazeSection.Rect.X + 10, belowMazeY + 20)
+        
+        // Charges left per action, only shown in charges mode
+        r.drawChargesHUD(screen, actionManager, mazeSection.Rect.X + mazeSection.Rect.Width - 10, belowMazeY + 20)
+    })
     
     // Draw action selection popup if in SelectingAction state
     if turnManager.CurrentState == turn.SelectingAction {
-        r.drawActionPopup(screen, actionManager)
+        layers.Add(PopupLayer, func(screen *ebiten.Image) {
+            r.drawActionPopup(screen, actionManager)
+        })
     }
     
     // Draw action message if active - overlay at the bottom of the screen
     if r.actionMsg != "" {
-        // Calculate message width for centering
-        msgWidth := len(r.actionMsg) * 14 // Approximate width based on character count
-        
-        // Draw a background rectangle for the message
-        msgBgX := ScreenWidth/2 - msgWidth/2 - 10
-        msgBgWidth := msgWidth + 20
-        
-        ebitenutil.DrawRect(screen, float64(msgBgX), ScreenHeight-60, float64(msgBgWidth), 30, color.RGBA{0, 0, 0, 180})
-        DrawText(screen, r.actionMsg, ScreenWidth/2-msgWidth/2, ScreenHeight-50)
+        layers.Add(MessageLayer, r.drawActionMessage)
+    }
+    
+    layers.Flush(screen)
+}
+
+// drawSectionFrame draws a layout section's border and title
+func (r *Renderer) drawSectionFrame(screen *ebiten.Image, section Section) {
+    if section.Border {
+        // Draw section border
+        borderColor := color.RGBA{70, 70, 100, 255}
+        ebitenutil.DrawRect(
+            screen,
+            float64(section.Rect.X),
+            float64(section.Rect.Y),
+            float64(section.Rect.Width),
+            float64(section.Rect.Height),
+            borderColor,
+        )
+    }
+    
+    // Draw section title
+    if section.Title != "" {
+        r.DrawText(screen, section.Title, section.Rect.X + 10, section.Rect.Y + 20)
     }
 }
 
+// drawActionMessage draws the current action message in a box at the bottom of the screen
+func (r *Renderer) drawActionMessage(screen *ebiten.Image) {
+    // Draw a background rectangle for the message
+    msgWidth := TextWidth(r.actionMsg)
+    msgBgX := CenteredX(r.actionMsg, ScreenWidth/2) - 10
+    msgBgWidth := msgWidth + 20
+    
+    ebitenutil.DrawRect(screen, float64(msgBgX), float64(ScreenHeight-60), float64(msgBgWidth), 30, r.actionKind.Color())
+    r.DrawTextCentered(screen, r.actionMsg, ScreenWidth/2, ScreenHeight-50)
+}
+
+// MazeOrigin returns the screen position of the maze's top-left corner,
+// centered in the maze section below its title
+func MazeOrigin(mazeObj *maze.Maze) (float64, float64) {
+    mazeSection := NewLayoutManager(ScreenWidth, ScreenHeight).GetSection(MazeSection)
+    mazeWidthPixels, _ := mazeObj.PixelSize()
+    
+    x := float64(mazeSection.Rect.X) + (float64(mazeSection.Rect.Width) - mazeWidthPixels) / 2
+    y := float64(mazeSection.Rect.Y) + 40 // Add space for title
+    return x, y
+}
+
+// NewCellPulse creates a pulse around a maze cell, used when an entity
+// jumps to the cell instead of walking there
+func (r *Renderer) NewCellPulse(mazeObj *maze.Maze, cell maze.Position) *animation.Pulse {
+    originX, originY, scale := MazeView(mazeObj, r.FitMaze)
+    tileSize := mazeObj.GetTileSize() * scale
+    return animation.NewPulse(
+        originX + float64(cell.X)*tileSize,
+        originY + float64(cell.Y)*tileSize,
+        tileSize,
+        color.RGBA{255, 255, 255, 255},
+    )
+}
+
+// NewCelebration creates the goal-reached animation, veiling the
+// game over message so the winner text fades in as it plays
+func (r *Renderer) NewCelebration() *animation.Celebration {
+	veil := animation.Rect{X: 100, Y: float64(ScreenHeight/2 - 20), Width: float64(ScreenWidth - 200), Height: 150}
+	return animation.NewCelebration(ScreenWidth, ScreenHeight, veil, BackgroundColor)
+}
+
+// drawEventLog renders recent events with the newest entry last
+func (r *Renderer) drawEventLog(screen *ebiten.Image, eventLog *eventlog.Log, x, y int) {
+	if eventLog == nil {
+		return
+	}
+
+	r.DrawText(screen, "Events", x, y)
+	for i, entry := range eventLog.Entries() {
+		r.DrawTextColor(screen, entry.String(), x, y+30+(i*24), color.RGBA{200, 200, 200, 255})
+	}
+}
+
 // Draw the game over screen
-func (r *Renderer) drawGameOver(screen *ebiten.Image, winner string) {
+func (r *Renderer) drawGameOver(screen *ebiten.Image, mazeObj *maze.Maze, info GameOverInfo) {
+	// Show the route the player took behind the results
+	r.drawRouteReview(screen, mazeObj, info.Route)
+
 	// Draw message background
-	ebitenutil.DrawRect(screen, 100, 200, ScreenWidth-200, 100, color.RGBA{50, 50, 80, 240})
+	ebitenutil.DrawRect(screen, 100, 200, float64(ScreenWidth-200), 100, color.RGBA{50, 50, 80, 240})
 	
 	// Draw winner message
-	winMessage := fmt.Sprintf("%s reached the goal first and won!", winner)
-	DrawText(screen, winMessage, ScreenWidth/2-120, ScreenHeight/2-10)
-	DrawText(screen, "Press SPACE to restart", ScreenWidth/2-100, ScreenHeight/2+20)
+	r.DrawTextCentered(screen, info.WinMessage(), ScreenWidth/2, ScreenHeight/2-10)
+	r.DrawTextCentered(screen, "Press SPACE to restart", ScreenWidth/2, ScreenHeight/2+20)
+	
+	// Draw best result for this maze size
+	if info.NewRecord {
+		r.DrawTextColor(screen, "New record!", ScreenWidth/2-100, ScreenHeight/2+60, color.RGBA{255, 215, 0, 255})
+	}
+	if info.BestRecord != "" {
+		r.DrawText(screen, "Best: "+info.BestRecord, ScreenWidth/2-100, ScreenHeight/2+90)
+	}
+
+	r.drawMatchStats(screen, info, ScreenWidth/2-100, ScreenHeight/2+140)
+	r.drawTriviaRecap(screen, info.TriviaRecap, ScreenWidth/2+260, ScreenHeight/2+140)
+}
+
+// WinMessage describes who won the match and how
+func (info GameOverInfo) WinMessage() string {
+	switch info.Reason {
+	case CaughtPlayer:
+		return fmt.Sprintf("%s caught the player and won!", info.Winner)
+	case WalledIn:
+		return fmt.Sprintf("%s closed in and left no way to the goal!", info.Winner)
+	case Survived:
+		return fmt.Sprintf("%s survived the chase and won!", info.Winner)
+	case TargetScore:
+		return fmt.Sprintf("%s reached the target score and won!", info.Winner)
+	default:
+		return fmt.Sprintf("%s reached the goal first and won!", info.Winner)
+	}
+}
+
+// drawRouteReview draws the final maze where it sat during play, with the
+// player's route traced over it
+func (r *Renderer) drawRouteReview(screen *ebiten.Image, mazeObj *maze.Maze, route []maze.Position) {
+	if mazeObj == nil {
+		return
+	}
+
+	mazeX, mazeY, mazeScale := MazeView(mazeObj, r.FitMaze)
+	opts := MazeDrawOptions{Trail: route}
+	if mazeScale >= 1 {
+		DrawMaze(screen, mazeObj, mazeX, mazeY, opts)
+		return
+	}
+
+	// Same as during play, a maze scaled to fit is drawn at full size first
+	board := r.boardTarget(mazeObj)
+	DrawMaze(board, mazeObj, 0, 0, opts)
+	op := &ebiten.DrawImageOptions{Filter: ebiten.FilterLinear}
+	op.GeoM.Scale(mazeScale, mazeScale)
+	op.GeoM.Translate(mazeX, mazeY)
+	screen.DrawImage(board, op)
+}
+
+// drawTriviaRecap lists the match's trivia answers beside the results panel
+func (r *Renderer) drawTriviaRecap(screen *ebiten.Image, recap []string, x, y int) {
+	if len(recap) == 0 {
+		return
+	}
+
+	lineHeight := 20
+	width := 0
+	for _, line := range recap {
+		if w := TextWidth(line); w > width {
+			width = w
+		}
+	}
+	ebitenutil.DrawRect(screen, float64(x-20), float64(y-25), float64(width+40), float64((len(recap)+1)*lineHeight+20), color.RGBA{40, 40, 60, 220})
+
+	r.DrawText(screen, "Trivia recap:", x, y)
+	for i, line := range recap {
+		r.DrawText(screen, line, x, y+(i+1)*lineHeight)
+	}
+}
+
+// drawMatchStats draws the results panel for the finished match
+func (r *Renderer) drawMatchStats(screen *ebiten.Image, info GameOverInfo, x, y int) {
+	lines := []string{
+		fmt.Sprintf("Winner: %s", info.Winner),
+		fmt.Sprintf("Turns: %d", info.Turns),
+		fmt.Sprintf("Player moves: %d", info.PlayerMoves),
+		fmt.Sprintf("Trivia: %d/%d correct", info.TriviaCorrect, info.TriviaTotal),
+		fmt.Sprintf("Score: %d", info.Score),
+		fmt.Sprintf("Time: %.1fs", info.Elapsed.Seconds()),
+		"Actions used:",
+	}
+	for _, actionLine := range info.ActionsUsed {
+		lines = append(lines, "  "+actionLine)
+	}
+
+	// Panel behind the stats
+	lineHeight := 20
+	ebitenutil.DrawRect(screen, float64(x-20), float64(y-25), 320, float64(len(lines)*lineHeight+20), color.RGBA{40, 40, 60, 220})
+
+	for i, line := range lines {
+		r.DrawText(screen, line, x, y+i*lineHeight)
+	}
 }
 
 // Draw the playing state
@@ -278,7 +677,7 @@ func (r *Renderer) drawPlaying(
 	actionManager *action.Manager,
 ) {
 	// Draw the maze grid using our new function
-	DrawMaze(screen, mazeObj, 0, 0) // Use 0, 0 as the offset (or adjust as needed)
+	DrawMaze(screen, mazeObj, 0, 0, r.mazeDrawOptions(playerObj)) // Use 0, 0 as the offset (or adjust as needed)
 
 	// Draw NPCs
 	for _, npc := range npcManager.NPCs {
@@ -299,28 +698,45 @@ func (r *Renderer) drawPlaying(
 
 	// Draw action message if active
 	if r.actionMsg != "" {
-		// Calculate message width for centering
-		msgWidth := len(r.actionMsg) * 14 // Approximate width based on character count
-		
 		// Draw a background rectangle for the message
-		msgBgX := ScreenWidth/2 - msgWidth/2 - 10
+		msgWidth := TextWidth(r.actionMsg)
+		msgBgX := CenteredX(r.actionMsg, ScreenWidth/2) - 10
 		msgBgWidth := msgWidth + 20
 		
-		ebitenutil.DrawRect(screen, float64(msgBgX), ScreenHeight-60, float64(msgBgWidth), 30, color.RGBA{0, 0, 0, 180})
-		DrawText(screen, r.actionMsg, ScreenWidth/2-msgWidth/2, ScreenHeight-50)
+		ebitenutil.DrawRect(screen, float64(msgBgX), float64(ScreenHeight-60), float64(msgBgWidth), 30, color.RGBA{0, 0, 0, 180})
+		r.DrawTextCentered(screen, r.actionMsg, ScreenWidth/2, ScreenHeight-50)
 	}
 }
 
+// drawOutline draws a 2px square outline
+func drawOutline(screen *ebiten.Image, x, y, size float64, clr color.Color) {
+	ebitenutil.DrawRect(screen, x-2, y-2, size+4, 2, clr) // Top
+	ebitenutil.DrawRect(screen, x-2, y+size, size+4, 2, clr) // Bottom
+	ebitenutil.DrawRect(screen, x-2, y, 2, size, clr) // Left
+	ebitenutil.DrawRect(screen, x+size, y, 2, size, clr) // Right
+}
+
 // Draw the UI
 func (r *Renderer) drawUI(screen *ebiten.Image, turnManager *turn.Manager) {
 	// Draw turn info using the turn manager
-	DrawText(screen, turnManager.OwnerText(), 10, 10)
+	r.DrawText(screen, turnManager.OwnerText(), 10, 10)
 
 	// Draw turn state info using the turn manager
-	DrawText(screen, turnManager.StateText(), 10, 30)
+	r.DrawText(screen, turnManager.StateText(), 10, 30)
 
 	// Draw goal info
-	DrawText(screen, "Reach the purple goal to win!", 10, 50)
+	r.DrawText(screen, "Reach the purple goal to win!", 10, 50)
+}
+
+// drawChargesHUD lists the charges left for each action, right-aligned to rightX
+func (r *Renderer) drawChargesHUD(screen *ebiten.Image, actionManager *action.Manager, rightX, y int) {
+	hud := strings.TrimSuffix(actionManager.FormatChargesHUD(), "\n")
+	if hud == "" {
+		return
+	}
+	for i, line := range strings.Split(hud, "\n") {
+		r.DrawTextRight(screen, line, rightX, y+i*20)
+	}
 }
 
 // Draw the action selection popup
@@ -330,16 +746,18 @@ func (r *Renderer) drawActionPopup(screen *ebiten.Image, actionManager *action.M
 	lines := strings.Split(actionText, "\n")
 	
 	// Calculate popup dimensions based on content
-	// Find the longest line to determine width
-	maxLineLength := 0
+	// Find the widest line, instructions included, to determine width
+	instructions := "Press number to select, ESC to cancel"
+	if actionManager.PageCount() > 1 {
+		instructions = "Number: select, Up/Down: page, ESC: cancel"
+	}
+	maxLineWidth := TextWidth(instructions)
 	for _, line := range lines {
-		if len(line) > maxLineLength {
-			maxLineLength = len(line)
-		}
+		maxLineWidth = max(maxLineWidth, TextWidth(line))
 	}
 	
 	// Calculate width and height with padding
-	width := maxLineLength*7 + 40 // Approximate width based on character count plus padding
+	width := maxLineWidth + 40
 	if width < 300 {
 		width = 300 // Minimum width
 	}
@@ -350,7 +768,7 @@ func (r *Renderer) drawActionPopup(screen *ebiten.Image, actionManager *action.M
 	}
 	
 	x := (ScreenWidth - width) / 2
-	y := (ScreenHeight - height) / 2
+	y := r.PopupAnchor.popupY(height)
 	
 	// Draw popup background
 	ebitenutil.DrawRect(screen, float64(x), float64(y), float64(width), float64(height), color.RGBA{70, 70, 100, 240})
@@ -358,31 +776,39 @@ func (r *Renderer) drawActionPopup(screen *ebiten.Image, actionManager *action.M
 	
 	// Draw action list
 	for i, line := range lines {
-		DrawText(screen, line, x+10, y+20+(i*20))
+		r.DrawText(screen, line, x+10, y+20+(i*20))
 	}
 	
 	// Draw instructions at the bottom
-	DrawText(screen, "Press number to select, ESC to cancel", x+10, y+height-20)
+	r.DrawText(screen, instructions, x+10, y+height-20)
 }
 
 // Draw the trivia screen
 func (r *Renderer) drawTrivia(screen *ebiten.Image, triviaManager *trivia.Manager) {
-	currentQuestion := triviaManager.GetCurrentQuestion()
+	currentQuestion, ok := triviaManager.GetCurrentQuestion()
+	if !ok {
+		return
+	}
 
 	// Draw question background
-	ebitenutil.DrawRect(screen, 50, 50, ScreenWidth-100, ScreenHeight-100, color.RGBA{50, 50, 80, 240})
+	ebitenutil.DrawRect(screen, 50, 50, float64(ScreenWidth-100), float64(ScreenHeight-100), color.RGBA{50, 50, 80, 240})
 
 	// Draw question
-	DrawText(screen, currentQuestion.Question, 70, 70)
+	r.DrawText(screen, currentQuestion.Question, 70, 70)
 
-	// Draw options
-	for i, option := range currentQuestion.Options {
-		optionYpadding := 60 * i
-		DrawText(screen, fmt.Sprintf("%d: %s", i+1, option), 70, (140 + optionYpadding))
+	// Draw options, packing them closer together when there are many
+	optionCount := currentQuestion.OptionCount()
+	optionSpacing := 60
+	if optionCount > 0 && (ScreenHeight-300)/optionCount < optionSpacing {
+		optionSpacing = (ScreenHeight - 300) / optionCount
+	}
+	for i, option := range currentQuestion.Options[:optionCount] {
+		optionYpadding := optionSpacing * i
+		r.DrawText(screen, fmt.Sprintf("%d: %s", i+1, option), 70, (140 + optionYpadding))
 	}
 
 	// Draw instructions
-	DrawText(screen, "Press 1-4 to answer", 70, ScreenHeight-100)
+	r.DrawText(screen, fmt.Sprintf("Press 1-%d to answer", optionCount), 70, ScreenHeight-100)
 
 	// If answered, show result
 	if triviaManager.Answered {
@@ -394,10 +820,8 @@ func (r *Renderer) drawTrivia(screen *ebiten.Image, triviaManager *trivia.Manage
 			//resultColor = color.RGBA{0, 255, 0, 255}
 		}
 
-		// Calculate message width for centering
-		msgWidth := len(resultText) * 9 // Approximate width based on character count
-		
-		DrawText(screen, resultText, ScreenWidth/2-msgWidth/2, ScreenHeight/2)
+		r.DrawTextCentered(screen, resultText, ScreenWidth/2, ScreenHeight/2)
+		r.DrawTextCentered(screen, "Press any key to continue", ScreenWidth/2, ScreenHeight/2+40)
 	}
 }
 
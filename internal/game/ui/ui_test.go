@@ -0,0 +1,25 @@
+// internal/game/ui/ui_test.go
+package ui
+
+import "testing"
+
+func TestWinMessageFollowsTheReason(t *testing.T) {
+	tests := []struct {
+		winner string
+		reason WinReason
+		want   string
+	}{
+		{"Player", ReachedGoal, "Player reached the goal first and won!"},
+		{"NPC 1", CaughtPlayer, "NPC 1 caught the player and won!"},
+		{"The Maze", WalledIn, "The Maze closed in and left no way to the goal!"},
+		{"Player", Survived, "Player survived the chase and won!"},
+		{"Team", TargetScore, "Team reached the target score and won!"},
+	}
+
+	for _, tt := range tests {
+		info := GameOverInfo{Winner: tt.winner, Reason: tt.reason}
+		if got := info.WinMessage(); got != tt.want {
+			t.Errorf("reason %d: WinMessage() = %q, want %q", tt.reason, got, tt.want)
+		}
+	}
+}
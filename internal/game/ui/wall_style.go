@@ -0,0 +1,77 @@
+// internal/game/ui/wall_style.go
+package ui
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+)
+
+// WallStyle is how wall tiles are drawn
+type WallStyle int
+
+const (
+	SolidWalls    WallStyle = iota // Flat grey blocks
+	BeveledWalls                   // Light top-left and dark bottom-right edges
+	TexturedWalls                  // The loaded wall texture, stretched over each wall
+)
+
+// String returns the name shown in the menu
+func (s WallStyle) String() string {
+	switch s {
+	case BeveledWalls:
+		return "Beveled"
+	case TexturedWalls:
+		return "Textured"
+	default:
+		return "Solid"
+	}
+}
+
+// Next returns the wall style that follows this one in the menu cycle
+func (s WallStyle) Next() WallStyle {
+	if s >= TexturedWalls {
+		return SolidWalls
+	}
+	return s + 1
+}
+
+// Resolve returns the style walls are actually drawn with: textured walls
+// fall back to solid when no texture could be loaded
+func (s WallStyle) Resolve(texture *ebiten.Image) WallStyle {
+	if s == TexturedWalls && texture == nil {
+		return SolidWalls
+	}
+	return s
+}
+
+// bevelFraction is how much of a tile's width each bevel edge takes
+const bevelFraction = 0.15
+
+// drawWall draws one wall tile in the given style. wallColor is the solid
+// fill, already dimmed by any lighting, whose alpha the texture follows too
+func drawWall(screen *ebiten.Image, tileX, tileY, tileSize float64, style WallStyle, texture *ebiten.Image, wallColor color.RGBA) {
+	switch style.Resolve(texture) {
+	case BeveledWalls:
+		edge := tileSize * bevelFraction
+		light := color.RGBA{130, 130, 130, wallColor.A}
+		dark := color.RGBA{35, 35, 35, wallColor.A}
+		ebitenutil.DrawRect(screen, tileX, tileY, tileSize, tileSize, wallColor)
+		ebitenutil.DrawRect(screen, tileX, tileY, tileSize, edge, light)              // Top
+		ebitenutil.DrawRect(screen, tileX, tileY, edge, tileSize, light)              // Left
+		ebitenutil.DrawRect(screen, tileX, tileY+tileSize-edge, tileSize, edge, dark) // Bottom
+		ebitenutil.DrawRect(screen, tileX+tileSize-edge, tileY, edge, tileSize, dark) // Right
+
+	case TexturedWalls:
+		bounds := texture.Bounds()
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Scale(tileSize/float64(bounds.Dx()), tileSize/float64(bounds.Dy()))
+		op.GeoM.Translate(tileX, tileY)
+		op.ColorScale.ScaleAlpha(float32(wallColor.A) / 255)
+		screen.DrawImage(texture, op)
+
+	default:
+		ebitenutil.DrawRect(screen, tileX, tileY, tileSize, tileSize, wallColor)
+	}
+}
@@ -0,0 +1,48 @@
+// internal/game/ui/wall_style_test.go
+package ui
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+func TestWallStyleResolves(t *testing.T) {
+	texture := ebiten.NewImage(8, 8)
+	tests := []struct {
+		style   WallStyle
+		texture *ebiten.Image
+		want    WallStyle
+	}{
+		{SolidWalls, nil, SolidWalls},
+		{BeveledWalls, nil, BeveledWalls},
+		{TexturedWalls, texture, TexturedWalls},
+		{TexturedWalls, nil, SolidWalls},
+	}
+	for _, tt := range tests {
+		if got := tt.style.Resolve(tt.texture); got != tt.want {
+			t.Errorf("%v with texture %v resolves to %v, want %v", tt.style, tt.texture != nil, got, tt.want)
+		}
+	}
+}
+
+func TestTexturedWallsWithoutTextureDrawSolid(t *testing.T) {
+	screen := ebiten.NewImage(40, 40)
+	defer func() {
+		if r := recover(); r != nil {
+			t.Errorf("drawing a textured wall with no texture panicked: %v", r)
+		}
+	}()
+	drawWall(screen, 0, 0, 40, TexturedWalls, nil, color.RGBA{80, 80, 80, 255})
+}
+
+func TestWallStyleCycle(t *testing.T) {
+	style := SolidWalls
+	for _, want := range []WallStyle{BeveledWalls, TexturedWalls, SolidWalls} {
+		style = style.Next()
+		if style != want {
+			t.Errorf("Next() = %v, want %v", style, want)
+		}
+	}
+}